@@ -0,0 +1,389 @@
+// Package cache implements cache of query results in front of executor.QueryExecutor.
+//
+// Cache entries are invalidated on writes into namespace: an entry is dropped only
+// if the changed record matches (or matched before the change) the query conditions
+// and the change can affect the result (membership or order by the query sorting).
+// Cached results store only IDs of records, records are fetched from namespace on each hit,
+// so changes of fields, which the query doesn't depend on, are visible without invalidation.
+// Entries are indexed by fields of their conditions and sortings: an update of a record checks only entries,
+// which read fields with changed results of conditions or order of sortings, insert and delete check all entries.
+//
+// Conditions of cached queries are used for invalidation, so they must not be changed after execution:
+// prepared queries (query.Prepare) change values of conditions by Bind, so they are executed without caching.
+package cache
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/shamcode/simd/executor"
+	"github.com/shamcode/simd/namespace"
+	"github.com/shamcode/simd/query"
+	"github.com/shamcode/simd/record"
+	"github.com/shamcode/simd/sort"
+	"github.com/shamcode/simd/where"
+)
+
+// Namespace is a source of records and notifications about writes.
+type Namespace[R record.Record] interface {
+	Get(id int64) (R, bool)
+	AddListener(listener namespace.Listener[R])
+}
+
+type entry[R record.Record] struct {
+	key        string
+	conditions where.Conditions[R]
+	sorting    []sort.ByWithOrder[R]
+	ids        []int64
+	withItems  bool
+	total      int
+	expiresAt  time.Time
+	reads      []read[R]
+}
+
+type QueryExecutor[R record.Record] struct {
+	executor   executor.QueryExecutor[R]
+	namespace  Namespace[R]
+	ttl        time.Duration
+	maxEntries int
+	now        func() time.Time
+	mu         sync.Mutex
+	generation uint64
+	entries    map[string]*list.Element
+	fields     fieldsIndex[R]
+	lru        *list.List
+}
+
+func (c *QueryExecutor[R]) FetchTotal(ctx context.Context, q query.Query[R]) (int, error) {
+	if !cacheable(q) {
+		return c.executor.FetchTotal(ctx, q)
+	}
+
+	key := Fingerprint(q)
+	if cached, ok := c.get(key); ok {
+		return cached.total, nil
+	}
+
+	generation := c.currentGeneration()
+
+	total, err := c.executor.FetchTotal(ctx, q)
+	if err != nil {
+		return 0, err
+	}
+
+	c.save(generation, &entry[R]{
+		key:        key,
+		conditions: q.Conditions(),
+		sorting:    q.Sorting(),
+		ids:        nil,
+		withItems:  false,
+		total:      total,
+		expiresAt:  time.Time{},
+		reads:      readsOf(q.Conditions(), q.Sorting()),
+	})
+
+	return total, nil
+}
+
+func (c *QueryExecutor[R]) FetchAll(ctx context.Context, q query.Query[R]) (executor.Iterator[R], error) {
+	iter, _, err := c.FetchAllAndTotal(ctx, q)
+	return iter, err
+}
+
+func (c *QueryExecutor[R]) FetchAllAndTotal(
+	ctx context.Context,
+	q query.Query[R],
+) (executor.Iterator[R], int, error) {
+	if !cacheable(q) {
+		return c.executor.FetchAllAndTotal(ctx, q)
+	}
+
+	key := Fingerprint(q)
+	if cached, ok := c.get(key); ok && cached.withItems {
+		if items, ok := c.materialize(cached.ids); ok {
+			return newSliceIterator(items), cached.total, nil
+		}
+	}
+
+	generation := c.currentGeneration()
+
+	iter, total, err := c.executor.FetchAllAndTotal(ctx, q)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	items := make([]R, 0, iter.Size())
+	ids := make([]int64, 0, iter.Size())
+
+	for item := range iter.Seq(ctx) {
+		items = append(items, item)
+		ids = append(ids, item.GetID())
+	}
+
+	if err := iter.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	c.save(generation, &entry[R]{
+		key:        key,
+		conditions: q.Conditions(),
+		sorting:    q.Sorting(),
+		ids:        ids,
+		withItems:  true,
+		total:      total,
+		expiresAt:  time.Time{},
+		reads:      readsOf(q.Conditions(), q.Sorting()),
+	})
+
+	return newSliceIterator(items), total, nil
+}
+
+// Len returns count of cached queries.
+func (c *QueryExecutor[R]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.lru.Len()
+}
+
+// Reset drops all cached queries.
+func (c *QueryExecutor[R]) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generation++
+	c.entries = make(map[string]*list.Element)
+	c.fields = make(fieldsIndex[R])
+	c.lru.Init()
+}
+
+func (c *QueryExecutor[R]) Insert(item R) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generation++
+
+	for _, cached := range c.all() {
+		if matches(cached.conditions, item) {
+			c.remove(cached)
+		}
+	}
+}
+
+func (c *QueryExecutor[R]) Delete(item R) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generation++
+
+	for _, cached := range c.all() {
+		if matches(cached.conditions, item) {
+			c.remove(cached)
+		}
+	}
+}
+
+// Update checks only entries, which read fields changed by the update.
+func (c *QueryExecutor[R]) Update(oldItem, item R) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generation++
+
+	for _, cached := range c.fields.changed(oldItem, item) {
+		oldMatches := matches(cached.conditions, oldItem)
+		newMatches := matches(cached.conditions, item)
+
+		if oldMatches != newMatches || (oldMatches && touched(cached, oldItem, item)) {
+			c.remove(cached)
+		}
+	}
+}
+
+// all returns all cached entries, it must be called under the lock.
+func (c *QueryExecutor[R]) all() []*entry[R] {
+	res := make([]*entry[R], 0, len(c.entries))
+	for _, elem := range c.entries {
+		res = append(res, elem.Value.(*entry[R])) //nolint:forcetypeassert
+	}
+
+	return res
+}
+
+// remove removes cached entry, it must be called under the lock.
+func (c *QueryExecutor[R]) remove(cached *entry[R]) {
+	elem, ok := c.entries[cached.key]
+	if !ok || elem.Value != cached {
+		return
+	}
+
+	c.lru.Remove(elem)
+	delete(c.entries, cached.key)
+	c.fields.remove(cached)
+}
+
+func (c *QueryExecutor[R]) currentGeneration() uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.generation
+}
+
+func (c *QueryExecutor[R]) get(key string) (*entry[R], bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	cached := elem.Value.(*entry[R]) //nolint:forcetypeassert
+	if !cached.expiresAt.IsZero() && !c.now().Before(cached.expiresAt) {
+		c.remove(cached)
+
+		return nil, false
+	}
+
+	c.lru.MoveToFront(elem)
+
+	return cached, true
+}
+
+// save saves entry, if namespace wasn't changed since the query execution has been started.
+func (c *QueryExecutor[R]) save(generation uint64, cached *entry[R]) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if generation != c.generation {
+		return
+	}
+
+	if c.ttl > 0 {
+		cached.expiresAt = c.now().Add(c.ttl)
+	}
+
+	if elem, ok := c.entries[cached.key]; ok {
+		c.fields.remove(elem.Value.(*entry[R])) //nolint:forcetypeassert
+		c.fields.add(cached)
+		elem.Value = cached
+		c.lru.MoveToFront(elem)
+
+		return
+	}
+
+	c.entries[cached.key] = c.lru.PushFront(cached)
+	c.fields.add(cached)
+
+	if c.maxEntries > 0 && c.lru.Len() > c.maxEntries {
+		c.remove(c.lru.Back().Value.(*entry[R])) //nolint:forcetypeassert
+	}
+}
+
+func (c *QueryExecutor[R]) materialize(ids []int64) ([]R, bool) {
+	items := make([]R, len(ids))
+
+	for i, id := range ids {
+		item, ok := c.namespace.Get(id)
+		if !ok {
+			return nil, false
+		}
+
+		items[i] = item
+	}
+
+	return items, true
+}
+
+// cacheable checks that the query can be cached: queries with errors are executed for error reporting,
+// queries with OnIteration callback are executed for callback side effects, set queries aren't cached,
+// because invalidation uses conditions of the query, and queries with parameters aren't cached,
+// because Bind changes values of conditions of the cached query.
+func cacheable[R record.Record](q query.Query[R]) bool {
+	_, isSet := q.(query.SetQuery[R])
+
+	return !isSet &&
+		len(query.SlotsOf(q)) == 0 &&
+		!computed(q.Conditions()) &&
+		prepared(q.Sorting()) &&
+		q.Error() == nil &&
+		q.OnIterationCallback() == nil
+}
+
+// computed checks that conditions contain predicates or expressions: they are fingerprinted by name only,
+// so queries with different functions of the same name would share the cached result.
+func computed[R record.Record](conditions where.Conditions[R]) bool {
+	for _, condition := range conditions {
+		if where.IsComputed(condition.Cmp) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// prepared checks that sortings don't require preparing for each execution (see sort.Preparer):
+// for example, order by relevance depends on statistics of all records, so any write changes it.
+func prepared[R record.Record](sorting []sort.ByWithOrder[R]) bool {
+	for _, by := range sorting {
+		if !sort.IsPrepared(by) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func matches[R record.Record](conditions where.Conditions[R], item R) bool {
+	res, err := conditions.Check(item)
+
+	return res || err != nil
+}
+
+// touched checks that order of the item in the cached result can be changed.
+func touched[R record.Record](cached *entry[R], oldItem, item R) bool {
+	if !cached.withItems {
+		return false
+	}
+
+	for _, by := range cached.sorting {
+		if by.Less(oldItem, item) || by.Less(item, oldItem) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CreateQueryExecutor creates cache in front of executor and subscribes it to namespace writes.
+func CreateQueryExecutor[R record.Record](
+	ns Namespace[R],
+	queryExecutor executor.QueryExecutor[R],
+	options ...Option,
+) *QueryExecutor[R] {
+	opts := &config{
+		ttl:        0,
+		maxEntries: 0,
+	}
+
+	for _, option := range options {
+		option(opts)
+	}
+
+	cache := &QueryExecutor[R]{ //nolint:exhaustruct
+		executor:   queryExecutor,
+		namespace:  ns,
+		ttl:        opts.ttl,
+		maxEntries: opts.maxEntries,
+		now:        time.Now,
+		entries:    make(map[string]*list.Element),
+		fields:     make(fieldsIndex[R]),
+		lru:        list.New(),
+	}
+
+	ns.AddListener(cache)
+
+	return cache
+}
@@ -0,0 +1,360 @@
+//nolint:exhaustruct
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	asserts "github.com/shamcode/assert"
+	"github.com/shamcode/simd/executor"
+	"github.com/shamcode/simd/namespace"
+	"github.com/shamcode/simd/query"
+	"github.com/shamcode/simd/record"
+	"github.com/shamcode/simd/sort"
+	"github.com/shamcode/simd/where"
+)
+
+type user struct {
+	id     int64
+	name   string
+	status uint8
+	score  int
+}
+
+func (u *user) GetID() int64 { return u.id }
+
+var userFields = record.NewFields()
+
+var name = record.ComparableGetter[*user, string]{
+	Field: userFields.New("name"),
+	Get:   func(item *user) string { return item.name },
+}
+
+var status = record.ComparableGetter[*user, uint8]{
+	Field: userFields.New("status"),
+	Get:   func(item *user) uint8 { return item.status },
+}
+
+var score = record.ComparableGetter[*user, int]{
+	Field: userFields.New("score"),
+	Get:   func(item *user) int { return item.score },
+}
+
+type countingExecutor struct {
+	executor.QueryExecutor[*user]
+	calls int
+}
+
+func (e *countingExecutor) FetchTotal(ctx context.Context, q query.Query[*user]) (int, error) {
+	e.calls++
+	return e.QueryExecutor.FetchTotal(ctx, q)
+}
+
+func (e *countingExecutor) FetchAllAndTotal(
+	ctx context.Context,
+	q query.Query[*user],
+) (executor.Iterator[*user], int, error) {
+	e.calls++
+	return e.QueryExecutor.FetchAllAndTotal(ctx, q)
+}
+
+// preparedScore is sorting by score, which is prepared for each execution like relevance of full-text index.
+type preparedScore struct{}
+
+func (preparedScore) Prepare() sort.By[*user] { return score }
+
+func (preparedScore) Less(a, b *user) bool { return score.Less(a, b) }
+
+func (preparedScore) String() string { return "prepared score" }
+
+func setup(t *testing.T, options ...Option) (*namespace.WithIndexes[*user], *countingExecutor, *QueryExecutor[*user]) {
+	t.Helper()
+
+	store := namespace.CreateNamespace[*user]()
+	for _, item := range []*user{
+		{id: 1, name: "foo", status: 1, score: 10},
+		{id: 2, name: "bar", status: 1, score: 20},
+		{id: 3, name: "baz", status: 2, score: 30},
+	} {
+		asserts.Success(t, store.Insert(item))
+	}
+
+	counter := &countingExecutor{QueryExecutor: executor.CreateQueryExecutor[*user](store)}
+
+	return store, counter, CreateQueryExecutor[*user](store, counter, options...)
+}
+
+func statusQuery(value uint8) query.Query[*user] {
+	return query.NewBuilder[*user]().
+		Where(query.Field(status, where.EQ, value)).
+		Sort(sort.Desc(score)).
+		Query()
+}
+
+func fetch(t *testing.T, cache *QueryExecutor[*user], q query.Query[*user]) []string {
+	t.Helper()
+
+	ctx := t.Context()
+	iter, err := cache.FetchAll(ctx, q)
+	asserts.Success(t, err)
+
+	var names []string
+	for item := range iter.Seq(ctx) {
+		names = append(names, item.name)
+	}
+
+	return names
+}
+
+func TestInvalidation(t *testing.T) {
+	testCases := []struct {
+		name          string
+		write         func(store *namespace.WithIndexes[*user]) error
+		expected      []string
+		expectedCalls int
+	}{
+		{
+			name:          "without writes",
+			write:         func(*namespace.WithIndexes[*user]) error { return nil },
+			expected:      []string{"bar", "foo"},
+			expectedCalls: 1,
+		},
+		{
+			name: "update field which query doesn't depend on",
+			write: func(store *namespace.WithIndexes[*user]) error {
+				return store.Upsert(&user{id: 1, name: "qux", status: 1, score: 10})
+			},
+			expected:      []string{"bar", "qux"},
+			expectedCalls: 1,
+		},
+		{
+			name: "insert not matched record",
+			write: func(store *namespace.WithIndexes[*user]) error {
+				return store.Insert(&user{id: 4, name: "qux", status: 2, score: 40})
+			},
+			expected:      []string{"bar", "foo"},
+			expectedCalls: 1,
+		},
+		{
+			name: "update not matched record",
+			write: func(store *namespace.WithIndexes[*user]) error {
+				return store.Upsert(&user{id: 3, name: "baz", status: 3, score: 30})
+			},
+			expected:      []string{"bar", "foo"},
+			expectedCalls: 1,
+		},
+		{
+			name: "insert matched record",
+			write: func(store *namespace.WithIndexes[*user]) error {
+				return store.Insert(&user{id: 4, name: "qux", status: 1, score: 40})
+			},
+			expected:      []string{"qux", "bar", "foo"},
+			expectedCalls: 2,
+		},
+		{
+			name: "update condition field",
+			write: func(store *namespace.WithIndexes[*user]) error {
+				return store.Upsert(&user{id: 3, name: "baz", status: 1, score: 30})
+			},
+			expected:      []string{"baz", "bar", "foo"},
+			expectedCalls: 2,
+		},
+		{
+			name: "update sort field",
+			write: func(store *namespace.WithIndexes[*user]) error {
+				return store.Upsert(&user{id: 1, name: "foo", status: 1, score: 50})
+			},
+			expected:      []string{"foo", "bar"},
+			expectedCalls: 2,
+		},
+		{
+			name: "delete matched record",
+			write: func(store *namespace.WithIndexes[*user]) error {
+				return store.Delete(2)
+			},
+			expected:      []string{"foo"},
+			expectedCalls: 2,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			store, counter, cache := setup(t)
+
+			asserts.Equals(t, []string{"bar", "foo"}, fetch(t, cache, statusQuery(1)), "before write")
+			asserts.Success(t, testCase.write(store))
+			asserts.Equals(t, testCase.expected, fetch(t, cache, statusQuery(1)), "after write")
+			asserts.Equals(t, testCase.expectedCalls, counter.calls, "executor calls")
+		})
+	}
+}
+
+func TestFieldsIndex(t *testing.T) {
+	store, _, cache := setup(t, WithMaxEntries(3))
+
+	nameQuery := query.NewBuilder[*user]().Where(query.Field(name, where.EQ, "foo")).Query()
+
+	fetch(t, cache, statusQuery(1))
+	fetch(t, cache, statusQuery(2))
+	fetch(t, cache, nameQuery)
+	asserts.Equals(t, 3, len(cache.fields), "fields of conditions and sorting")
+	asserts.Equals(t, 2, len(cache.fields[status.Index()].entries), "entries of status")
+
+	item := &user{id: 1, name: "foo", status: 1, score: 10}
+	testCases := []struct {
+		name     string
+		updated  *user
+		expected int
+	}{
+		{name: "name", updated: &user{id: 1, name: "qux", status: 1, score: 10}, expected: 1},
+		{name: "status", updated: &user{id: 1, name: "foo", status: 2, score: 10}, expected: 2},
+		{name: "score", updated: &user{id: 1, name: "foo", status: 1, score: 50}, expected: 2},
+		{name: "nothing", updated: &user{id: 1, name: "foo", status: 1, score: 10}, expected: 0},
+	}
+
+	for _, testCase := range testCases {
+		asserts.Equals(t, testCase.expected, len(cache.fields.changed(item, testCase.updated)), testCase.name)
+	}
+
+	asserts.Success(t, store.Upsert(&user{id: 1, name: "foo", status: 2, score: 10}))
+	asserts.Equals(t, 1, cache.Len(), "entries after update")
+	asserts.Equals(t, 1, len(cache.fields), "fields after update")
+
+	fetch(t, cache, statusQuery(1))
+	fetch(t, cache, statusQuery(2))
+	fetch(t, cache, query.NewBuilder[*user]().Where(query.Field(name, where.EQ, "bar")).Query())
+	asserts.Equals(t, 3, cache.Len(), "entries after eviction")
+	asserts.Equals(t, 2, len(cache.fields[status.Index()].entries), "entries of status after eviction")
+	asserts.Equals(t, 1, len(cache.fields[name.Index()].reads), "reads of name after eviction")
+
+	cache.Reset()
+	asserts.Equals(t, 0, len(cache.fields), "fields after reset")
+}
+
+func TestFingerprint(t *testing.T) {
+	first := query.NewBuilder[*user]().Where(query.Field(name, where.InArray, "a", "b")).Limit(1).Query()
+	second := query.NewBuilder[*user]().Where(query.Field(name, where.InArray, "b", "a")).Limit(1).Query()
+	third := query.NewBuilder[*user]().Where(query.Field(name, where.InArray, "a", "b")).Limit(2).Query()
+
+	asserts.Equals(t, Fingerprint(first), Fingerprint(second), "values order")
+	asserts.Equals(t, false, Fingerprint(first) == Fingerprint(third), "limit")
+
+	isFoo := func(item *user) (bool, error) { return item.name == "foo", nil }
+	firstPredicate := query.NewBuilder[*user]().Where(query.Predicate("is_foo", isFoo)).Query()
+	secondPredicate := query.NewBuilder[*user]().Where(query.Predicate("is_not_foo", isFoo)).Query()
+
+	asserts.Equals(t, false, Fingerprint(firstPredicate) == Fingerprint(secondPredicate), "predicate name")
+
+	nameAndStatus := query.NewBuilder[*user]().Where(query.Field(name, where.EQ, "a")).Where(query.Field(status, where.EQ, 1)).Query()
+	reversed := query.NewBuilder[*user]().Where(query.Field(status, where.EQ, 1)).Where(query.Field(name, where.EQ, "a")).Query()
+
+	asserts.Equals(t, Fingerprint(nameAndStatus), Fingerprint(reversed), "operands order")
+}
+
+func TestLimits(t *testing.T) {
+	t.Run("ttl", func(t *testing.T) {
+		_, counter, cache := setup(t, WithTTL(time.Minute))
+
+		now := time.Now()
+		cache.now = func() time.Time { return now }
+
+		fetch(t, cache, statusQuery(1))
+		fetch(t, cache, statusQuery(1))
+		asserts.Equals(t, 1, counter.calls, "before expiration")
+
+		now = now.Add(time.Minute)
+
+		fetch(t, cache, statusQuery(1))
+		asserts.Equals(t, 2, counter.calls, "after expiration")
+	})
+
+	t.Run("max entries", func(t *testing.T) {
+		_, counter, cache := setup(t, WithMaxEntries(2))
+
+		fetch(t, cache, statusQuery(1))
+		fetch(t, cache, statusQuery(2))
+		fetch(t, cache, statusQuery(1))
+		fetch(t, cache, statusQuery(3))
+		asserts.Equals(t, 2, cache.Len(), "entries")
+		asserts.Equals(t, 3, counter.calls, "calls before eviction")
+
+		fetch(t, cache, statusQuery(1))
+		asserts.Equals(t, 3, counter.calls, "recently used entry is kept")
+
+		fetch(t, cache, statusQuery(2))
+		asserts.Equals(t, 4, counter.calls, "least recently used entry is evicted")
+	})
+
+	t.Run("total", func(t *testing.T) {
+		_, counter, cache := setup(t)
+
+		total, err := cache.FetchTotal(t.Context(), statusQuery(1))
+		asserts.Success(t, err)
+		asserts.Equals(t, 2, total, "total")
+
+		total, err = cache.FetchTotal(t.Context(), statusQuery(1))
+		asserts.Success(t, err)
+		asserts.Equals(t, 2, total, "cached total")
+		asserts.Equals(t, 1, counter.calls, "calls")
+	})
+	t.Run("set query", func(t *testing.T) {
+		_, counter, cache := setup(t)
+
+		q := query.Union(statusQuery(1), statusQuery(2)).Sort(sort.Asc(score)).Query()
+
+		asserts.Equals(t, []string{"foo", "bar", "baz"}, fetch(t, cache, q), "names")
+		asserts.Equals(t, []string{"foo", "bar", "baz"}, fetch(t, cache, q), "names")
+		asserts.Equals(t, 2, counter.calls, "set query isn't cached")
+		asserts.Equals(t, 0, cache.Len(), "entries")
+	})
+	t.Run("predicate", func(t *testing.T) {
+		_, counter, cache := setup(t)
+
+		byScore := func(minScore int) query.Query[*user] {
+			return query.NewBuilder[*user]().
+				Where(query.Predicate("by_score", func(item *user) (bool, error) { return item.score > minScore, nil })).
+				Sort(sort.Desc(score)).
+				Query()
+		}
+
+		asserts.Equals(t, []string{"baz"}, fetch(t, cache, byScore(20)), "first predicate")
+		asserts.Equals(t, []string{"baz", "bar"}, fetch(t, cache, byScore(10)), "predicate with the same name")
+		asserts.Equals(t, 2, counter.calls, "query with predicate isn't cached")
+		asserts.Equals(t, 0, cache.Len(), "entries")
+	})
+	t.Run("prepared query", func(t *testing.T) {
+		store, counter, cache := setup(t)
+
+		value := query.Param[uint8]("status")
+		prepared, err := query.Prepare(
+			query.NewBuilder[*user]().Where(query.FieldParam(status, where.EQ, value)).Sort(sort.Desc(score)).Query(),
+			value,
+		)
+		asserts.Success(t, err)
+
+		bind := func(status uint8) query.Query[*user] {
+			q, err := prepared.Bind(map[string]any{"status": status})
+			asserts.Success(t, err)
+
+			return q
+		}
+
+		asserts.Equals(t, []string{"bar", "foo"}, fetch(t, cache, bind(1)), "first bind")
+		asserts.Equals(t, []string{"baz"}, fetch(t, cache, bind(2)), "second bind")
+		asserts.Success(t, store.Insert(&user{id: 4, name: "qux", status: 1, score: 40}))
+		asserts.Equals(t, []string{"qux", "bar", "foo"}, fetch(t, cache, bind(1)), "after insert")
+		asserts.Equals(t, 3, counter.calls, "prepared query isn't cached")
+		asserts.Equals(t, 0, cache.Len(), "entries")
+	})
+	t.Run("prepared sorting", func(t *testing.T) {
+		_, counter, cache := setup(t)
+
+		q := query.NewBuilder[*user]().Where(query.Field(status, where.EQ, 1)).Sort(sort.Desc(preparedScore{})).Query()
+
+		asserts.Equals(t, []string{"bar", "foo"}, fetch(t, cache, q), "first")
+		asserts.Equals(t, []string{"bar", "foo"}, fetch(t, cache, q), "second")
+		asserts.Equals(t, 2, counter.calls, "query with prepared sorting isn't cached")
+		asserts.Equals(t, 0, cache.Len(), "entries")
+	})
+}
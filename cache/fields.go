@@ -0,0 +1,144 @@
+package cache
+
+import (
+	"fmt"
+
+	"github.com/shamcode/simd/record"
+	"github.com/shamcode/simd/sort"
+	"github.com/shamcode/simd/where"
+	"github.com/shamcode/simd/where/ast"
+)
+
+// read is a condition or a sorting of cached query, which reads the field. Changed checks that the update
+// of the record changes result of the condition or order of the sorting.
+type read[R record.Record] struct {
+	field   uint16
+	key     string
+	changed func(oldItem, item R) bool
+}
+
+// readsOf returns reads of fields by conditions and sorting. Sortings, which aren't getters of fields,
+// read the whole record like computed fields, so they are tracked by record.ComputedFieldIndex.
+func readsOf[R record.Record](conditions where.Conditions[R], sorting []sort.ByWithOrder[R]) []read[R] {
+	reads := make([]read[R], 0, len(conditions)+len(sorting))
+
+	for _, condition := range conditions {
+		cmp := condition.Cmp
+
+		reads = append(reads, read[R]{
+			field: cmp.GetField().Index(),
+			key:   fmt.Sprintf("%T", cmp) + ast.Fingerprint[R](ast.Leaf[R]{Cmp: cmp}),
+			changed: func(oldItem, item R) bool {
+				oldRes, oldErr := cmp.Compare(oldItem)
+				res, err := cmp.Compare(item)
+
+				return oldRes != res || oldErr != nil || err != nil
+			},
+		})
+	}
+
+	for _, by := range sorting {
+		unwrapped, _ := sort.Unwrap(by)
+
+		field := record.ComputedFieldIndex
+		if getter, ok := unwrapped.(record.Field); ok {
+			field = getter.Index()
+		}
+
+		reads = append(reads, read[R]{
+			field: field,
+			key:   fmt.Sprintf("%T", unwrapped) + by.String(),
+			changed: func(oldItem, item R) bool {
+				return by.Less(oldItem, item) || by.Less(item, oldItem)
+			},
+		})
+	}
+
+	return reads
+}
+
+// fieldReads contains cached entries, which read the field, and distinct reads of these entries.
+// Reads with the same key are shared by entries, refs is a count of their usages.
+type fieldReads[R record.Record] struct {
+	entries map[*entry[R]]struct{}
+	reads   map[string]*sharedRead[R]
+}
+
+type sharedRead[R record.Record] struct {
+	read[R]
+
+	refs int
+}
+
+// fieldsIndex contains cached entries by indexes of fields, which they read.
+type fieldsIndex[R record.Record] map[uint16]*fieldReads[R]
+
+func (idx fieldsIndex[R]) add(cached *entry[R]) {
+	for _, cachedRead := range cached.reads {
+		fields, ok := idx[cachedRead.field]
+		if !ok {
+			fields = &fieldReads[R]{
+				entries: make(map[*entry[R]]struct{}),
+				reads:   make(map[string]*sharedRead[R]),
+			}
+			idx[cachedRead.field] = fields
+		}
+
+		fields.entries[cached] = struct{}{}
+
+		if shared, ok := fields.reads[cachedRead.key]; ok {
+			shared.refs++
+		} else {
+			fields.reads[cachedRead.key] = &sharedRead[R]{read: cachedRead, refs: 1}
+		}
+	}
+}
+
+func (idx fieldsIndex[R]) remove(cached *entry[R]) {
+	for _, cachedRead := range cached.reads {
+		fields, ok := idx[cachedRead.field]
+		if !ok {
+			continue
+		}
+
+		delete(fields.entries, cached)
+
+		if shared, ok := fields.reads[cachedRead.key]; ok {
+			shared.refs--
+			if shared.refs == 0 {
+				delete(fields.reads, cachedRead.key)
+			}
+		}
+
+		if len(fields.entries) == 0 {
+			delete(idx, cachedRead.field)
+		}
+	}
+}
+
+// changed returns entries, which read fields changed by the update: any read of the field changes result.
+// Each distinct read is checked once for all entries, which share it.
+func (idx fieldsIndex[R]) changed(oldItem, item R) []*entry[R] {
+	var res []*entry[R]
+
+	seen := make(map[*entry[R]]struct{})
+
+	for _, fields := range idx {
+		for _, shared := range fields.reads {
+			if !shared.changed(oldItem, item) {
+				continue
+			}
+
+			for cached := range fields.entries {
+				if _, ok := seen[cached]; !ok {
+					seen[cached] = struct{}{}
+					res = append(res, cached)
+				}
+			}
+
+			break
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,47 @@
+package cache
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/shamcode/simd/query"
+	"github.com/shamcode/simd/record"
+	"github.com/shamcode/simd/sort"
+	"github.com/shamcode/simd/where/ast"
+)
+
+// Fingerprint returns normalized representation of the query, which used as key of the cache.
+// Conditions are represented by ast.Fingerprint of normalized tree, so queries with different order of operands
+// of AND and OR or different order of values of IN, NOT IN, SET_HAS_ANY and SET_HAS_ALL conditions have the same
+// fingerprint. Sorting is represented by type of sort.By and sort.By.String(), so it must be unique for different
+// sortings of the same type.
+// Predicates are represented by name, so it must be unique for different functions, queries with predicates
+// and expressions aren't cached by QueryExecutor.
+func Fingerprint[R record.Record](q query.Query[R]) string {
+	var builder strings.Builder
+
+	builder.WriteString("W")
+	builder.WriteString(ast.Fingerprint(ast.Normalize(ast.FromConditions(q.Conditions()))))
+	builder.WriteString(";")
+
+	for _, by := range q.Sorting() {
+		unwrapped, _ := sort.Unwrap(by)
+
+		builder.WriteString("S")
+		builder.WriteString(strconv.Quote(fmt.Sprintf("%T", unwrapped)))
+		builder.WriteString(",")
+		builder.WriteString(strconv.Quote(by.String()))
+		builder.WriteString(";")
+	}
+
+	builder.WriteString("O")
+	builder.WriteString(strconv.Itoa(q.Offset()))
+
+	if limit, withLimit := q.Limit(); withLimit {
+		builder.WriteString("L")
+		builder.WriteString(strconv.Itoa(limit))
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,61 @@
+package cache
+
+import (
+	"context"
+	"iter"
+
+	"github.com/shamcode/simd/executor"
+	"github.com/shamcode/simd/record"
+)
+
+type sliceIterator[R record.Record] struct {
+	items     []R
+	index     int
+	lastError error
+}
+
+func (i *sliceIterator[R]) Next(ctx context.Context) bool {
+	select {
+	case <-ctx.Done():
+		i.lastError = ctx.Err()
+		return false
+	default:
+		if i.index >= len(i.items) {
+			return false
+		}
+
+		i.index += 1
+
+		return true
+	}
+}
+
+func (i *sliceIterator[R]) Item() R {
+	return i.items[i.index-1]
+}
+
+func (i *sliceIterator[R]) Err() error {
+	return i.lastError
+}
+
+func (i *sliceIterator[R]) Size() int {
+	return len(i.items)
+}
+
+func (i *sliceIterator[R]) Seq(ctx context.Context) iter.Seq[R] {
+	return func(yield func(R) bool) {
+		for i.Next(ctx) {
+			if !yield(i.Item()) {
+				return
+			}
+		}
+	}
+}
+
+func newSliceIterator[R record.Record](items []R) executor.Iterator[R] {
+	return &sliceIterator[R]{
+		items:     items,
+		index:     0,
+		lastError: nil,
+	}
+}
@@ -0,0 +1,25 @@
+package cache
+
+import "time"
+
+type config struct {
+	ttl        time.Duration
+	maxEntries int
+}
+
+type Option func(cfg *config)
+
+// WithTTL sets lifetime of cached queries. Zero (default) means entries live until invalidation.
+func WithTTL(ttl time.Duration) Option {
+	return func(cfg *config) {
+		cfg.ttl = ttl
+	}
+}
+
+// WithMaxEntries limits count of cached queries, least recently used entries are evicted.
+// Zero (default) means unlimited.
+func WithMaxEntries(maxEntries int) Option {
+	return func(cfg *config) {
+		cfg.maxEntries = maxEntries
+	}
+}
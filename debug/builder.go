@@ -2,8 +2,10 @@ package debug
 
 import (
 	"fmt"
+	"reflect"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/shamcode/simd/record"
 	"github.com/shamcode/simd/sort"
@@ -61,8 +63,14 @@ func (q *debugQueryBuilder[R]) openBracket() {
 		}
 	}
 
+	if q.withNot {
+		chunk.WriteString("NOT ")
+	}
+
 	chunk.WriteString("(")
 
+	q.withNot = false
+	q.isOr = false
 	q.requireOp = false
 }
 
@@ -102,7 +110,7 @@ func (q *debugQueryBuilder[R]) makeCopy() *debugQueryBuilder[R] {
 	}
 }
 
-func (q *debugQueryBuilder[R]) saveFieldComparatorForDump(cmp where.FieldComparator[R]) { //nolint:funlen,cyclop
+func (q *debugQueryBuilder[R]) saveFieldComparatorForDump(cmp where.FieldComparator[R]) {
 	chunk := q.chunks[chunkWhere]
 	if q.requireOp {
 		if q.isOr {
@@ -116,45 +124,65 @@ func (q *debugQueryBuilder[R]) saveFieldComparatorForDump(cmp where.FieldCompara
 		chunk.WriteString("NOT ")
 	}
 
+	if cmp.GetType() == where.Predicate {
+		chunk.WriteString("PREDICATE(")
+		chunk.WriteString(cmp.GetField().String())
+		chunk.WriteString(")")
+
+		return
+	}
+
 	chunk.WriteString(cmp.GetField().String())
+	q.writeOperator(chunk, cmp)
+}
 
+// elementComparator is implemented by comparators of where.SliceAny with comparator for element.
+type elementComparator[R record.Record] interface {
+	GetElement() where.FieldComparator[R]
+}
+
+// writeOperator writes operator and values of comparator in format supported by ql package.
+func (q *debugQueryBuilder[R]) writeOperator(chunk *strings.Builder, cmp where.FieldComparator[R]) { //nolint:funlen,cyclop
 	switch cmp.GetType() {
 	case where.EQ:
 		chunk.WriteString(" = ")
-		fmt.Fprintf(chunk, "%v", cmp.ValueAt(0))
+		writeValue(chunk, cmp.ValueAt(0))
 	case where.GT:
 		chunk.WriteString(" > ")
-		fmt.Fprintf(chunk, "%v", cmp.ValueAt(0))
+		writeValue(chunk, cmp.ValueAt(0))
 	case where.GE:
 		chunk.WriteString(" >= ")
-		fmt.Fprintf(chunk, "%v", cmp.ValueAt(0))
+		writeValue(chunk, cmp.ValueAt(0))
 	case where.LT:
 		chunk.WriteString(" < ")
-		fmt.Fprintf(chunk, "%v", cmp.ValueAt(0))
+		writeValue(chunk, cmp.ValueAt(0))
 	case where.LE:
 		chunk.WriteString(" <= ")
-		fmt.Fprintf(chunk, "%v", cmp.ValueAt(0))
+		writeValue(chunk, cmp.ValueAt(0))
+	case where.NE:
+		chunk.WriteString(" != ")
+		writeValue(chunk, cmp.ValueAt(0))
 	case where.InArray:
-		chunk.WriteString(" IN (")
-
-		for i := range cmp.ValuesCount() {
-			if i != 0 {
-				chunk.WriteString(", ")
-			}
-
-			fmt.Fprintf(chunk, "%v", cmp.ValueAt(i))
-		}
-
-		chunk.WriteString(")")
+		chunk.WriteString(" IN ")
+		writeList(chunk, cmp)
+	case where.NotInArray:
+		chunk.WriteString(" NOT IN ")
+		writeList(chunk, cmp)
 	case where.Like:
 		chunk.WriteString(" LIKE ")
-		fmt.Fprintf(chunk, "\"%v\"", cmp.ValueAt(0))
+		writeValue(chunk, cmp.ValueAt(0))
 	case where.Regexp:
 		chunk.WriteString(" REGEXP ")
-		fmt.Fprintf(chunk, "%v", cmp.ValueAt(0))
+		chunk.WriteString(strconv.Quote(fmt.Sprint(cmp.ValueAt(0))))
 	case where.SetHas:
 		chunk.WriteString(" SET_HAS ")
-		fmt.Fprintf(chunk, "%v", cmp.ValueAt(0))
+		writeValue(chunk, cmp.ValueAt(0))
+	case where.SetHasAny:
+		chunk.WriteString(" SET_HAS_ANY ")
+		writeList(chunk, cmp)
+	case where.SetHasAll:
+		chunk.WriteString(" SET_HAS_ALL ")
+		writeList(chunk, cmp)
 	case where.MapHasValue:
 		chunk.WriteString(" MAP_HAS_VALUE FIELD ")
 
@@ -163,7 +191,42 @@ func (q *debugQueryBuilder[R]) saveFieldComparatorForDump(cmp where.FieldCompara
 		fmt.Fprintf(chunk, " COMPARE %v", mapCmp)
 	case where.MapHasKey:
 		chunk.WriteString(" MAP_HAS_KEY ")
-		fmt.Fprintf(chunk, "\"%v\"", cmp.ValueAt(0))
+		writeValue(chunk, cmp.ValueAt(0))
+	case where.SliceContains:
+		chunk.WriteString(" SLICE_CONTAINS ")
+		writeValue(chunk, cmp.ValueAt(0))
+	case where.SliceContainsAll:
+		chunk.WriteString(" SLICE_CONTAINS_ALL ")
+		writeList(chunk, cmp)
+	case where.SliceAny:
+		chunk.WriteString(" SLICE_ANY")
+
+		if withElement, ok := cmp.(elementComparator[R]); ok {
+			q.writeOperator(chunk, withElement.GetElement())
+		}
+	case where.MapKeyValueEQ:
+		chunk.WriteString(" MAP_KEY_VALUE_EQ ")
+		writeValue(chunk, cmp.ValueAt(0))
+		chunk.WriteString(" ")
+		writeValue(chunk, cmp.ValueAt(1))
+	case where.Between:
+		writeBetween(chunk, cmp.ValueAt(0), cmp.ValueAt(1), where.BoundsOf(cmp))
+	case where.StartsWith:
+		chunk.WriteString(" STARTS_WITH ")
+		writeValue(chunk, cmp.ValueAt(0))
+	case where.Fuzzy:
+		chunk.WriteString(" FUZZY ")
+		writeValue(chunk, cmp.ValueAt(0))
+		fmt.Fprintf(chunk, " %v %v", cmp.ValueAt(1), cmp.ValueAt(2))
+	case where.Match:
+		chunk.WriteString(" MATCH ")
+		writeValue(chunk, cmp.ValueAt(0))
+	case where.IsNull:
+		chunk.WriteString(" IS NULL")
+	case where.IsNotNull:
+		chunk.WriteString(" IS NOT NULL")
+	case where.Predicate:
+		// Predicate has no operator, it's written with name by saveFieldComparatorForDump
 	default:
 		if nil == q.fieldComparatorDumper {
 			fmt.Fprintf(chunk, " (ComparatorType(%d) ", cmp.GetType())
@@ -183,6 +246,65 @@ func (q *debugQueryBuilder[R]) saveFieldComparatorForDump(cmp where.FieldCompara
 	}
 }
 
+// writeValue writes value in format supported by ql package: strings are quoted.
+func writeValue(chunk *strings.Builder, value any) {
+	if t, ok := value.(time.Time); ok {
+		chunk.WriteString(strconv.Quote(t.Format(time.RFC3339Nano)))
+		return
+	}
+
+	if rv := reflect.ValueOf(value); rv.Kind() == reflect.String {
+		chunk.WriteString(strconv.Quote(rv.String()))
+		return
+	}
+
+	fmt.Fprintf(chunk, "%v", value)
+}
+
+func writeList[R record.Record](chunk *strings.Builder, cmp where.FieldComparator[R]) {
+	chunk.WriteString("(")
+
+	for i := range cmp.ValuesCount() {
+		if i != 0 {
+			chunk.WriteString(", ")
+		}
+
+		writeValue(chunk, cmp.ValueAt(i))
+	}
+
+	chunk.WriteString(")")
+}
+
+// writeBetween writes inclusive range in format supported by ql package, other ranges in interval notation.
+func writeBetween(chunk *strings.Builder, low, high any, bounds where.Bounds) {
+	if bounds == where.Inclusive {
+		chunk.WriteString(" BETWEEN ")
+		writeValue(chunk, low)
+		chunk.WriteString(" AND ")
+		writeValue(chunk, high)
+
+		return
+	}
+
+	chunk.WriteString(" BETWEEN ")
+
+	if bounds.IncludeLow() {
+		chunk.WriteString("[")
+	} else {
+		chunk.WriteString("(")
+	}
+
+	writeValue(chunk, low)
+	chunk.WriteString(", ")
+	writeValue(chunk, high)
+
+	if bounds.IncludeHigh() {
+		chunk.WriteString("]")
+	} else {
+		chunk.WriteString(")")
+	}
+}
+
 func (q *debugQueryBuilder[R]) Dump() string {
 	var result strings.Builder
 	if q.chunks[chunkWhere].Len() > 0 {
@@ -6,9 +6,11 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	asserts "github.com/shamcode/assert"
 	"github.com/shamcode/simd/executor"
+	"github.com/shamcode/simd/expr"
 	"github.com/shamcode/simd/query"
 	"github.com/shamcode/simd/record"
 	"github.com/shamcode/simd/sort"
@@ -16,9 +18,10 @@ import (
 )
 
 type user struct {
-	ID   int64
-	Name string
-	Age  int
+	ID        int64
+	Name      string
+	Age       int
+	CreatedAt time.Time
 }
 
 func (u *user) GetID() int64 {
@@ -43,6 +46,13 @@ var age = record.ComparableGetter[*user, int]{
 	},
 }
 
+var createdAt = record.TimeGetter[*user]{
+	Field: userFields.New("created_at"),
+	Get: func(item *user) time.Time {
+		return item.CreatedAt
+	},
+}
+
 type storage struct {
 	data map[int64]*user
 }
@@ -149,6 +159,20 @@ func TestQueryExecutorWithDebug(t *testing.T) { //nolint:maintidx
 				Query(),
 			expected: "SELECT *, COUNT(*) WHERE age >= 18 AND age <= 22 ORDER BY ID ASC",
 		},
+		{
+			name: "where age between 18 and 22",
+			query: WrapBuilder(query.NewBuilder[*user]()).
+				Where(query.Field(age, where.Between, 18, 22)).
+				Query(),
+			expected: "SELECT *, COUNT(*) WHERE age BETWEEN 18 AND 22",
+		},
+		{
+			name: "where age between 18 and 22 exclude high",
+			query: WrapBuilder(query.NewBuilder[*user]()).
+				Where(query.FieldBetween(age, 18, 22, where.ExcludeHigh)).
+				Query(),
+			expected: "SELECT *, COUNT(*) WHERE age BETWEEN [18, 22)",
+		},
 		{
 			name: "where ID = 2 or ID = 5",
 			query: WrapBuilder(query.NewBuilder[*user]()).
@@ -267,6 +291,13 @@ func TestQueryExecutorWithDebug(t *testing.T) { //nolint:maintidx
 				Query(),
 			expected: "SELECT *, COUNT(*) WHERE age IN (20, 21, 22) AND ID > 3 ORDER BY ID ASC",
 		},
+		{
+			name: "where name = \"first\"",
+			query: WrapBuilder(query.NewBuilder[*user]()).
+				Where(query.Field(name, where.EQ, "first")).
+				Query(),
+			expected: "SELECT *, COUNT(*) WHERE name = \"first\"",
+		},
 		{
 			name: "where name like \"th\"",
 			query: WrapBuilder(query.NewBuilder[*user]()).
@@ -323,6 +354,62 @@ func TestQueryExecutorWithDebug(t *testing.T) { //nolint:maintidx
 				Query(),
 			expected: "SELECT *, COUNT(*) WHERE (((ID = 1) OR (ID = 2)) OR ID = 3) OR ID = 4 ORDER BY ID ASC",
 		},
+		{
+			name: "where NOT (ID = 1 OR NOT (age > 20 AND NOT (age < 22)))",
+			query: WrapBuilder(query.NewBuilder[*user]()).
+				Not().
+				OpenBracket().
+				Where(query.Field(id, where.EQ, 1)).
+				Or().
+				Not().
+				OpenBracket().
+				Where(query.Field(age, where.GT, 20)).
+				Not().
+				OpenBracket().
+				Where(query.Field(age, where.LT, 22)).
+				CloseBracket().
+				CloseBracket().
+				CloseBracket().
+				Query(),
+			expected: "SELECT *, COUNT(*) WHERE NOT (ID = 1 OR NOT (age > 20 AND NOT (age < 22)))",
+		},
+		{
+			name: "predicate",
+			query: WrapBuilder(query.NewBuilder[*user]()).
+				Not().
+				Where(query.Predicate("is_adult", func(item *user) (bool, error) { return item.Age >= 21, nil })).
+				Or().
+				Where(query.Field(id, where.EQ, 5)).
+				Query(),
+			expected: "SELECT *, COUNT(*) WHERE NOT PREDICATE(is_adult) OR ID = 5",
+		},
+		{
+			name: "expression",
+			query: WrapBuilder(query.NewBuilder[*user]()).
+				Where(query.Expression(
+					expr.Sub(expr.Mul(expr.Field(age), expr.Value[*user](2)), expr.Len(expr.Lower(expr.Field(name)))),
+					where.GT,
+					expr.Value[*user](30),
+				)).
+				Where(query.Expression(expr.Lower(expr.Field(name)), where.NE, expr.Value[*user]("Fifth"))).
+				Query(),
+			expected: "SELECT *, COUNT(*) WHERE age * 2 - len(lower(name)) > 30 AND lower(name) != \"Fifth\"",
+		},
+		{
+			name: "time",
+			query: WrapBuilder(query.NewBuilder[*user]()).
+				Where(query.FieldTime(createdAt, where.GE, time.Date(2022, time.March, 1, 10, 0, 0, 0, time.UTC))).
+				Where(query.FieldTimeBetween(
+					createdAt,
+					time.Date(2022, time.January, 1, 0, 0, 0, 0, time.FixedZone("+03:00", 3*60*60)),
+					time.Date(2022, time.April, 1, 0, 0, 0, 500, time.UTC),
+					where.ExcludeHigh,
+				)).
+				Sort(sort.Desc(createdAt)).
+				Query(),
+			expected: "SELECT *, COUNT(*) WHERE created_at >= \"2022-03-01T10:00:00Z\" AND " +
+				"created_at BETWEEN [\"2022-01-01T00:00:00+03:00\", \"2022-04-01T00:00:00.0000005Z\") ORDER BY created_at DESC",
+		},
 		{
 			name: "where ID > 1 limit 2 offset 1 order by ID ASC",
 			query: WrapBuilder(query.NewBuilder[*user]()).
@@ -377,7 +464,7 @@ func TestFieldComparatorDumper(t *testing.T) {
 	ns.insert(&user{ID: 4, Name: "fourth", Age: 21})
 	ns.insert(&user{ID: 5, Name: "fifth", Age: 22})
 
-	InRange := where.ComparatorType(20)
+	InRange := where.CustomComparatorBase
 
 	dumper := func(w *strings.Builder, cmp where.FieldComparator[*user]) {
 		if InRange == cmp.GetType() {
@@ -405,7 +492,7 @@ func TestFieldComparatorDumper(t *testing.T) {
 				Sort(sort.Asc(id)).
 				Query(),
 			expected:             "SELECT *, COUNT(*) WHERE ID IN RANGE (3; 10) AND NOT age <= 21 ORDER BY ID ASC OFFSET 1 LIMIT 2",
-			expectedErrorMessage: "execute query: not implemented ComparatorType: 20, field = ID",
+			expectedErrorMessage: "execute query: not implemented ComparatorType: 128, field = ID",
 		},
 		{
 			name: "IN RANGE With copy",
@@ -419,7 +506,7 @@ func TestFieldComparatorDumper(t *testing.T) {
 				MakeCopy().
 				Query(),
 			expected:             "SELECT *, COUNT(*) WHERE ID IN RANGE (3; 10) AND NOT age <= 21 ORDER BY ID ASC OFFSET 1 LIMIT 2",
-			expectedErrorMessage: "execute query: not implemented ComparatorType: 20, field = ID",
+			expectedErrorMessage: "execute query: not implemented ComparatorType: 128, field = ID",
 		},
 	}
 
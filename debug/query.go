@@ -20,6 +20,10 @@ func (dq *debugQuery[R]) String() string {
 	return dq.queryDump
 }
 
+func (dq *debugQuery[R]) Slots() []query.Slot {
+	return query.SlotsOf(dq.Query)
+}
+
 func NewQueryWithDumper[R record.Record](
 	query query.Query[R],
 	dumpString string,
@@ -0,0 +1,140 @@
+// Package distance implements edit distances between strings for fuzzy matching.
+// Strings are compared by runes.
+package distance
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrUnknownMetric = errors.New("unknown metric")
+
+// Metric is an edit distance, both metrics satisfy triangle inequality and can be used by BK-tree.
+type Metric uint8
+
+const (
+	// Levenshtein counts insertions, deletions and substitutions.
+	Levenshtein Metric = iota + 1
+	// Damerau counts insertions, deletions, substitutions and transpositions of adjacent characters.
+	Damerau
+)
+
+// Distance returns edit distance between a and b.
+func (m Metric) Distance(a, b string) int {
+	if m == Levenshtein {
+		return levenshtein([]rune(a), []rune(b))
+	}
+
+	return damerau([]rune(a), []rune(b))
+}
+
+func (m Metric) String() string {
+	switch m {
+	case Levenshtein:
+		return "LEVENSHTEIN"
+	case Damerau:
+		return "DAMERAU"
+	default:
+		return ""
+	}
+}
+
+// ParseMetric returns metric by name, case-insensitive.
+func ParseMetric(name string) (Metric, error) {
+	for _, metric := range []Metric{Levenshtein, Damerau} {
+		if strings.EqualFold(name, metric.String()) {
+			return metric, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w: %s", ErrUnknownMetric, name)
+}
+
+// Radius returns maximal distance of metric m for strings with distance edits by metric other.
+// Transposition is a single edit for Damerau, but two edits for Levenshtein.
+func (m Metric) Radius(other Metric, edits int) int {
+	if m == Levenshtein && other == Damerau {
+		return 2 * edits //nolint:mnd
+	}
+
+	return edits
+}
+
+func levenshtein(a, b []rune) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := range a {
+		current[0] = i + 1
+
+		for j := range b {
+			cost := 1
+			if a[i] == b[j] {
+				cost = 0
+			}
+
+			current[j+1] = min(previous[j+1]+1, current[j]+1, previous[j]+cost)
+		}
+
+		previous, current = current, previous
+	}
+
+	return previous[len(b)]
+}
+
+// damerau implements unrestricted Damerau-Levenshtein distance (Lowrance-Wagner algorithm),
+// unlike optimal string alignment it is a metric.
+func damerau(a, b []rune) int {
+	maxDistance := len(a) + len(b)
+	lastRow := make(map[rune]int)
+
+	// d has additional first row and column with maxDistance
+	d := make([][]int, len(a)+2) //nolint:mnd
+	for i := range d {
+		d[i] = make([]int, len(b)+2) //nolint:mnd
+		d[i][0] = maxDistance
+	}
+
+	for j := range d[0] {
+		d[0][j] = maxDistance
+	}
+
+	for i := 0; i <= len(a); i++ {
+		d[i+1][1] = i
+	}
+
+	for j := 0; j <= len(b); j++ {
+		d[1][j+1] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		lastColumn := 0
+
+		for j := 1; j <= len(b); j++ {
+			lastI := lastRow[b[j-1]]
+			lastJ := lastColumn
+
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+				lastColumn = j
+			}
+
+			d[i+1][j+1] = min(
+				d[i][j]+cost,
+				d[i+1][j]+1,
+				d[i][j+1]+1,
+				d[lastI][lastJ]+(i-lastI-1)+1+(j-lastJ-1),
+			)
+		}
+
+		lastRow[a[i-1]] = i
+	}
+
+	return d[len(a)+1][len(b)+1]
+}
@@ -0,0 +1,32 @@
+package distance
+
+import (
+	"testing"
+
+	asserts "github.com/shamcode/assert"
+)
+
+func TestDistance(t *testing.T) {
+	testCases := []struct {
+		a, b        string
+		levenshtein int
+		damerau     int
+	}{
+		{a: "", b: "", levenshtein: 0, damerau: 0},
+		{a: "", b: "abc", levenshtein: 3, damerau: 3},
+		{a: "kitten", b: "sitting", levenshtein: 3, damerau: 3},
+		{a: "john", b: "jonh", levenshtein: 2, damerau: 1},
+		{a: "ca", b: "abc", levenshtein: 3, damerau: 2},
+		{a: "Müller", b: "Muller", levenshtein: 1, damerau: 1},
+		{a: "smith", b: "smith", levenshtein: 0, damerau: 0},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.a+"/"+testCase.b, func(t *testing.T) {
+			asserts.Equals(t, testCase.levenshtein, Levenshtein.Distance(testCase.a, testCase.b), "levenshtein")
+			asserts.Equals(t, testCase.levenshtein, Levenshtein.Distance(testCase.b, testCase.a), "levenshtein reversed")
+			asserts.Equals(t, testCase.damerau, Damerau.Distance(testCase.a, testCase.b), "damerau")
+			asserts.Equals(t, testCase.damerau, Damerau.Distance(testCase.b, testCase.a), "damerau reversed")
+		})
+	}
+}
@@ -13,6 +13,13 @@ type TimeFieldComparator[R record.Record] struct {
 	Cmp    where.ComparatorType
 	Getter types.TimeGetter[R]
 	Value  []time.Time
+
+	// Bounds is used by where.Between, Value contains low and high values.
+	Bounds where.Bounds
+}
+
+func (fc TimeFieldComparator[R]) GetBounds() where.Bounds {
+	return fc.Bounds
 }
 
 func (fc TimeFieldComparator[R]) GetType() where.ComparatorType {
@@ -35,6 +42,8 @@ func (fc TimeFieldComparator[R]) CompareValue(value time.Time) (bool, error) {
 		return value.Equal(fc.Value[0]) || value.After(fc.Value[0]), nil
 	case where.LE:
 		return value.Equal(fc.Value[0]) || value.Before(fc.Value[0]), nil
+	case where.Between:
+		return fc.Bounds.Contains(value.Compare(fc.Value[0]), value.Compare(fc.Value[1])), nil
 	default:
 		return false, comparators.NewNotImplementComparatorError(fc.GetField(), fc.Cmp)
 	}
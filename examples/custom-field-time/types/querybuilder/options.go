@@ -22,6 +22,24 @@ func FieldTime[R record.Record](
 			Cmp:    condition,
 			Getter: getter,
 			Value:  value,
+			Bounds: where.Inclusive,
+		},
+		Error: nil,
+	}
+}
+
+// FieldTimeBetween add condition for check field with time.Time type is in range from low to high.
+func FieldTimeBetween[R record.Record](
+	getter types.TimeGetter[R],
+	low, high time.Time,
+	bounds where.Bounds,
+) query.WhereOption[R] {
+	return query.WhereOption[R]{
+		Cmp: comparators.TimeFieldComparator[R]{
+			Cmp:    where.Between,
+			Getter: getter,
+			Value:  []time.Time{low, high},
+			Bounds: bounds,
 		},
 		Error: nil,
 	}
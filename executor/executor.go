@@ -3,6 +3,7 @@ package executor
 
 import (
 	"context"
+	"slices"
 
 	"github.com/shamcode/simd/record"
 
@@ -33,7 +34,7 @@ func (e *executor[R]) FetchAllAndTotal(ctx context.Context, q query.Query[R]) (I
 	return e.exec(ctx, q, false)
 }
 
-func (e *executor[R]) exec( //nolint:cyclop,funlen
+func (e *executor[R]) exec(
 	ctx context.Context,
 	q query.Query[R],
 	onlyTotal bool,
@@ -45,60 +46,151 @@ func (e *executor[R]) exec( //nolint:cyclop,funlen
 	total := 0
 	items := newHeap(q.Sorting())
 	callback := q.OnIterationCallback()
+
+	err := e.each(ctx, q, func(item R) {
+		if nil != callback {
+			(*callback)(item)
+		}
+
+		total += 1
+
+		if !onlyTotal {
+			items.Push(item)
+		}
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if onlyTotal {
+		return nil, total, nil
+	}
+
+	var (
+		last int
+		size int
+	)
+
+	itemsCount := total
+	from := min(q.Offset(), itemsCount)
+
+	if limit, withLimit := q.Limit(); withLimit {
+		last = min(from+limit, itemsCount)
+	} else {
+		last = itemsCount
+	}
+
+	size = last - from
+
+	return newHeapIterator(items, from, last, size), total, nil
+}
+
+// each calls fn for each record satisfied the query.
+func (e *executor[R]) each(ctx context.Context, q query.Query[R], fn func(item R)) error {
+	if set, ok := q.(query.SetQuery[R]); ok {
+		return e.eachOfSet(ctx, set, fn)
+	}
+
 	conditions := q.Conditions()
 
 	itemsForCheck, err := e.selector.PreselectForExecutor(ctx, conditions)
 	if err != nil {
-		return nil, 0, NewExecuteQueryError(err)
+		return NewExecuteQueryError(err)
 	}
 
 	for _, item := range itemsForCheck {
 		select {
 		case <-ctx.Done():
-			return nil, 0, ctx.Err()
+			return ctx.Err()
 		default:
 			res, err := conditions.Check(item)
 			if err != nil {
-				return nil, 0, NewExecuteQueryError(err)
+				return NewExecuteQueryError(err)
 			}
 
-			if !res {
-				continue
+			if res {
+				fn(item)
 			}
+		}
+	}
+
+	return nil
+}
 
-			if nil != callback {
-				(*callback)(item)
+// eachOfSet calls fn for each record of the combined result of the set query.
+// Each query of the set is executed separately with own preselect by indexes, see eachResult.
+func (e *executor[R]) eachOfSet(ctx context.Context, set query.SetQuery[R], fn func(item R)) error {
+	queries := set.Queries()
+
+	if set.Operation() == query.UnionOperation {
+		seen := make(map[int64]struct{})
+
+		for _, q := range queries {
+			err := e.eachResult(ctx, q, func(item R) {
+				if _, ok := seen[item.GetID()]; !ok {
+					seen[item.GetID()] = struct{}{}
+					fn(item)
+				}
+			})
+			if err != nil {
+				return err
 			}
+		}
 
-			total += 1
+		return nil
+	}
 
-			if !onlyTotal {
-				items.Push(item)
-			}
+	if len(queries) == 0 {
+		return nil
+	}
+
+	var items []R
+	if err := e.eachResult(ctx, queries[0], func(item R) { items = append(items, item) }); err != nil {
+		return err
+	}
+
+	except := set.Operation() == query.ExceptOperation
+
+	for _, q := range queries[1:] {
+		if len(items) == 0 {
+			break
 		}
+
+		ids := make(map[int64]struct{})
+		if err := e.eachResult(ctx, q, func(item R) { ids[item.GetID()] = struct{}{} }); err != nil {
+			return err
+		}
+
+		items = slices.DeleteFunc(items, func(item R) bool {
+			_, ok := ids[item.GetID()]
+			return ok == except
+		})
 	}
 
-	if onlyTotal {
-		return nil, total, nil
+	for _, item := range items {
+		fn(item)
 	}
 
-	var (
-		last int
-		size int
-	)
+	return nil
+}
 
-	itemsCount := total
+// eachResult calls fn for each record of the query result. Offset, limit with sorting and OnIteration callback
+// of the query are applied as for separate execution of the query.
+func (e *executor[R]) eachResult(ctx context.Context, q query.Query[R], fn func(item R)) error {
+	if _, withLimit := q.Limit(); !withLimit && q.Offset() == 0 && nil == q.OnIterationCallback() {
+		return e.each(ctx, q, fn)
+	}
 
-	if limit, withLimit := q.Limit(); withLimit {
-		last = min(q.Offset()+limit, itemsCount)
+	iter, _, err := e.exec(ctx, q, false)
+	if err != nil {
+		return err
+	}
 
-		size = min(limit, itemsCount)
-	} else {
-		last = itemsCount
-		size = itemsCount
+	for item := range iter.Seq(ctx) {
+		fn(item)
 	}
 
-	return newHeapIterator(items, q.Offset(), last, size), total, nil
+	return iter.Err()
 }
 
 func CreateQueryExecutor[R record.Record](selector Selector[R]) QueryExecutor[R] {
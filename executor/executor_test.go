@@ -2,6 +2,7 @@ package executor
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	asserts "github.com/shamcode/assert"
@@ -96,6 +97,16 @@ func TestQueryExecutor(t *testing.T) { //nolint:maintidx
 			query:    query.NewBuilder[*user]().Sort(sort.Desc[*user](id)).Query(),
 			expected: []int64{5, 4, 3, 2, 1},
 		},
+		{
+			name:     "order by age desc offset 2 limit 2",
+			query:    query.NewBuilder[*user]().Sort(sort.Desc[*user](age)).Offset(2).Limit(2).Query(),
+			expected: []int64{3, 2},
+		},
+		{
+			name:     "order by name asc offset 1",
+			query:    query.NewBuilder[*user]().Sort(sort.Asc[*user](name)).Offset(1).Query(),
+			expected: []int64{1, 4, 2, 3},
+		},
 		{
 			name: "where id = int64(3)",
 			query: query.NewBuilder[*user]().
@@ -368,3 +379,123 @@ func TestQueryExecutor(t *testing.T) { //nolint:maintidx
 		})
 	}
 }
+
+func TestSetQuery(t *testing.T) {
+	ns := &storage{
+		data: make(map[int64]*user),
+	}
+	asserts.Success(t, ns.Insert(&user{ID: 1, Name: "first", Age: 18}))
+	asserts.Success(t, ns.Insert(&user{ID: 2, Name: "second", Age: 19}))
+	asserts.Success(t, ns.Insert(&user{ID: 3, Name: "third", Age: 20}))
+	asserts.Success(t, ns.Insert(&user{ID: 4, Name: "fourth", Age: 21}))
+	asserts.Success(t, ns.Insert(&user{ID: 5, Name: "fifth", Age: 22}))
+
+	ageGreater := func(value int) query.Query[*user] {
+		return query.NewBuilder[*user]().Where(query.Field(age, where.GT, value)).Query()
+	}
+	nameLike := func(value string) query.Query[*user] {
+		return query.NewBuilder[*user]().Where(query.Field(name, where.Like, value)).Query()
+	}
+
+	tests := []struct {
+		name          string
+		query         query.Query[*user]
+		expected      []int64
+		expectedTotal int
+	}{
+		{
+			name:          "union",
+			query:         query.Union(nameLike("th"), nameLike("first"), ageGreater(20)).Sort(sort.Asc[*user](id)).Query(),
+			expected:      []int64{1, 3, 4, 5},
+			expectedTotal: 4,
+		},
+		{
+			name:          "intersect",
+			query:         query.Intersect(nameLike("th"), ageGreater(18), ageGreater(20)).Sort(sort.Desc[*user](id)).Query(),
+			expected:      []int64{5, 4},
+			expectedTotal: 2,
+		},
+		{
+			name:          "except",
+			query:         query.Except(ageGreater(18), nameLike("th"), nameLike("first")).Sort(sort.Asc[*user](id)).Query(),
+			expected:      []int64{2},
+			expectedTotal: 1,
+		},
+		{
+			name: "nested",
+			query: query.Except[*user](
+				query.Union(nameLike("first"), ageGreater(19)).Query(),
+				query.Intersect(nameLike("th"), ageGreater(20)).Query(),
+			).Sort(sort.Asc[*user](id)).Query(),
+			expected:      []int64{1, 3},
+			expectedTotal: 2,
+		},
+		{
+			name: "offset and limit",
+			query: query.Union(nameLike("th"), nameLike("first"), nameLike("second")).
+				Sort(sort.Asc[*user](age)).
+				Offset(1).
+				Limit(3).
+				Query(),
+			expected:      []int64{2, 3, 4},
+			expectedTotal: 5,
+		},
+		{
+			name: "limit and offset of queries",
+			query: query.Union(
+				query.NewBuilder[*user]().Sort(sort.Desc[*user](age)).Limit(2).Query(),
+				query.NewBuilder[*user]().Sort(sort.Asc[*user](age)).Offset(1).Limit(1).Query(),
+			).Sort(sort.Asc[*user](id)).Query(),
+			expected:      []int64{2, 4, 5},
+			expectedTotal: 3,
+		},
+		{
+			name:          "offset greater than total",
+			query:         query.Union(nameLike("th"), nameLike("first")).Offset(10).Limit(3).Query(),
+			expected:      []int64{},
+			expectedTotal: 4,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			iter, total, err := CreateQueryExecutor[*user](ns).FetchAllAndTotal(ctx, test.query)
+			asserts.Success(t, err)
+
+			res := make([]int64, 0, iter.Size())
+			for item := range iter.Seq(ctx) {
+				res = append(res, item.ID)
+			}
+
+			asserts.Equals(t, test.expected, res, "ids")
+			asserts.Equals(t, len(test.expected), iter.Size(), "size")
+			asserts.Equals(t, test.expectedTotal, total, "total")
+		})
+	}
+
+	t.Run("OnIteration of queries", func(t *testing.T) {
+		var names []string
+
+		inner := query.NewBuilder[*user]().
+			Where(query.Field(age, where.GT, 20)).
+			OnIteration(func(item *user) { names = append(names, item.Name) }).
+			Query()
+
+		total, err := CreateQueryExecutor[*user](ns).FetchTotal(t.Context(), query.Except(inner, nameLike("fifth")).Query())
+		asserts.Success(t, err)
+		asserts.Equals(t, 1, total, "total")
+		asserts.Equals(t, 2, len(names), "callback calls")
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		_, err := CreateQueryExecutor[*user](ns).FetchTotal(t.Context(), query.Union(nameLike("th")).Query())
+		asserts.Equals(t, true, errors.Is(err, query.ErrNotEnoughQueries), "not enough queries")
+
+		invalid := query.NewBuilder[*user]().CloseBracket().Query()
+		_, err = CreateQueryExecutor[*user](ns).FetchTotal(t.Context(), query.Intersect(nameLike("th"), invalid).Query())
+		asserts.Equals(t, true, errors.Is(err, query.ErrCloseBracketWithoutOpen), "invalid query")
+	})
+}
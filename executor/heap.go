@@ -88,8 +88,14 @@ func (h *binaryHeap[R]) down(i0, n int) bool {
 	return i > i0
 }
 
+// newHeap creates heap for the query execution, sortings are prepared once for the execution (see sort.Preparer).
 func newHeap[R record.Record](sorting []sort.ByWithOrder[R]) *binaryHeap[R] {
+	prepared := make([]sort.ByWithOrder[R], len(sorting))
+	for i, by := range sorting {
+		prepared[i] = sort.Prepare(by)
+	}
+
 	return &binaryHeap[R]{ //nolint:exhaustruct
-		sorting: sorting,
+		sorting: prepared,
 	}
 }
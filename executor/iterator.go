@@ -16,7 +16,7 @@ type Iterator[R record.Record] interface {
 }
 
 type heapIterator[R record.Record] struct {
-	from      int
+	skip      int
 	index     int
 	max       int
 	size      int
@@ -30,6 +30,11 @@ func (i *heapIterator[R]) Next(ctx context.Context) bool {
 		i.lastError = ctx.Err()
 		return false
 	default:
+		// Records before offset are removed from the heap in sorted order
+		for ; i.skip > 0; i.skip-- {
+			i.heap.Remove(0)
+		}
+
 		result := i.index < i.max
 		if result {
 			i.index += 1
@@ -40,7 +45,7 @@ func (i *heapIterator[R]) Next(ctx context.Context) bool {
 }
 
 func (i *heapIterator[R]) Item() R {
-	return i.heap.Remove(i.from)
+	return i.heap.Remove(0)
 }
 
 func (i *heapIterator[R]) Err() error {
@@ -63,7 +68,7 @@ func (i *heapIterator[R]) Seq(ctx context.Context) iter.Seq[R] {
 
 func newHeapIterator[R record.Record](heap *binaryHeap[R], from, to, size int) Iterator[R] {
 	return &heapIterator[R]{ //nolint:exhaustruct
-		from:  from,
+		skip:  from,
 		index: from,
 		max:   to,
 		size:  size,
@@ -0,0 +1,144 @@
+// Package expr implements expressions over record fields for conditions, which can't be expressed by a single field,
+// for example score*2 + bonus > 100:
+//
+//	query.Expression(
+//		expr.Add(expr.Mul(expr.Field(score), expr.Value[*User](2)), expr.Field(bonus)),
+//		where.GT,
+//		expr.Value[*User](100),
+//	)
+//
+// Operands of arithmetic have the same type, use Float for mixing integer and float expressions.
+package expr
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/shamcode/simd/record"
+)
+
+var ErrDivisionByZero = errors.New("division by zero")
+
+// Number is a type of numeric values supported by arithmetic.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~float32 | ~float64
+}
+
+// precedence of expression is used for placing parentheses in String.
+type precedence uint8
+
+const (
+	precedenceSum precedence = iota + 1
+	precedenceProduct
+	precedenceOperand
+)
+
+// Expr is an expression evaluated to value of type T for the record.
+type Expr[R record.Record, T any] struct {
+	eval       func(item R) (T, error)
+	str        string
+	precedence precedence
+}
+
+// Eval evaluates the expression for the record.
+func (e Expr[R, T]) Eval(item R) (T, error) {
+	return e.eval(item)
+}
+
+// String returns the expression in infix notation, for example score * 2 + bonus.
+func (e Expr[R, T]) String() string {
+	return e.str
+}
+
+// Field creates expression with value of the field.
+func Field[R record.Record, T record.LessComparable](getter record.ComparableGetter[R, T]) Expr[R, T] {
+	return Expr[R, T]{
+		eval: func(item R) (T, error) {
+			return getter.Get(item), nil
+		},
+		str:        getter.String(),
+		precedence: precedenceOperand,
+	}
+}
+
+// Value creates constant expression.
+func Value[R record.Record, T any](value T) Expr[R, T] {
+	str := fmt.Sprint(value)
+	if s, ok := any(value).(string); ok {
+		str = strconv.Quote(s)
+	}
+
+	return Expr[R, T]{
+		eval: func(R) (T, error) {
+			return value, nil
+		},
+		str:        str,
+		precedence: precedenceOperand,
+	}
+}
+
+// Add creates expression a + b.
+func Add[R record.Record, T Number](a, b Expr[R, T]) Expr[R, T] {
+	return binary(a, "+", b, precedenceSum, func(x, y T) (T, error) { return x + y, nil })
+}
+
+// Sub creates expression a - b.
+func Sub[R record.Record, T Number](a, b Expr[R, T]) Expr[R, T] {
+	return binary(a, "-", b, precedenceSum, func(x, y T) (T, error) { return x - y, nil })
+}
+
+// Mul creates expression a * b.
+func Mul[R record.Record, T Number](a, b Expr[R, T]) Expr[R, T] {
+	return binary(a, "*", b, precedenceProduct, func(x, y T) (T, error) { return x * y, nil })
+}
+
+// Div creates expression a / b, evaluation returns ErrDivisionByZero if b is zero.
+func Div[R record.Record, T Number](a, b Expr[R, T]) Expr[R, T] {
+	return binary(a, "/", b, precedenceProduct, func(x, y T) (T, error) {
+		if y == 0 {
+			return 0, ErrDivisionByZero
+		}
+
+		return x / y, nil
+	})
+}
+
+func binary[R record.Record, T any](
+	a Expr[R, T],
+	op string,
+	b Expr[R, T],
+	prec precedence,
+	apply func(x, y T) (T, error),
+) Expr[R, T] {
+	left := a.str
+	if a.precedence < prec {
+		left = "(" + left + ")"
+	}
+
+	// Right operand with the same precedence is wrapped too: a - (b - c)
+	right := b.str
+	if b.precedence <= prec {
+		right = "(" + right + ")"
+	}
+
+	return Expr[R, T]{
+		eval: func(item R) (T, error) {
+			x, err := a.eval(item)
+			if err != nil {
+				return x, err
+			}
+
+			y, err := b.eval(item)
+			if err != nil {
+				return y, err
+			}
+
+			return apply(x, y)
+		},
+		str:        left + " " + op + " " + right,
+		precedence: prec,
+	}
+}
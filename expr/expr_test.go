@@ -0,0 +1,117 @@
+//nolint:exhaustruct
+package expr
+
+import (
+	"errors"
+	"testing"
+
+	asserts "github.com/shamcode/assert"
+	"github.com/shamcode/simd/record"
+)
+
+type user struct {
+	name  string
+	score int
+	bonus int
+}
+
+func (u *user) GetID() int64 { return 0 }
+
+var fields = record.NewFields()
+
+var name = record.ComparableGetter[*user, string]{
+	Field: fields.New("name"),
+	Get:   func(item *user) string { return item.name },
+}
+
+var score = record.ComparableGetter[*user, int]{
+	Field: fields.New("score"),
+	Get:   func(item *user) int { return item.score },
+}
+
+var bonus = record.ComparableGetter[*user, int]{
+	Field: fields.New("bonus"),
+	Get:   func(item *user) int { return item.bonus },
+}
+
+func TestExpr(t *testing.T) {
+	item := &user{name: "Jürgen", score: 40, bonus: -7}
+
+	testCases := []struct {
+		expr     interface{ String() string }
+		expected any
+		str      string
+	}{
+		{
+			expr:     Add(Mul(Field(score), Value[*user](2)), Field(bonus)),
+			expected: 73,
+			str:      "score * 2 + bonus",
+		},
+		{
+			expr:     Mul(Add(Field(score), Field(bonus)), Value[*user](2)),
+			expected: 66,
+			str:      "(score + bonus) * 2",
+		},
+		{
+			expr:     Sub(Field(score), Sub(Field(bonus), Value[*user](3))),
+			expected: 50,
+			str:      "score - (bonus - 3)",
+		},
+		{
+			expr:     Div(Field(score), Abs(Field(bonus))),
+			expected: 5,
+			str:      "score / abs(bonus)",
+		},
+		{
+			expr:     Div(Float(Field(score)), Value[*user](16.0)),
+			expected: 2.5,
+			str:      "float(score) / 16",
+		},
+		{
+			expr:     Len(Upper(Field(name))),
+			expected: 6,
+			str:      "len(upper(name))",
+		},
+		{
+			expr:     Lower(Field(name)),
+			expected: "jürgen",
+			str:      "lower(name)",
+		},
+		{
+			expr:     Value[*user]("foo"),
+			expected: "foo",
+			str:      `"foo"`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.str, func(t *testing.T) {
+			var (
+				value any
+				err   error
+			)
+
+			switch e := testCase.expr.(type) {
+			case Expr[*user, int]:
+				value, err = e.Eval(item)
+			case Expr[*user, float64]:
+				value, err = e.Eval(item)
+			case Expr[*user, string]:
+				value, err = e.Eval(item)
+			}
+
+			asserts.Success(t, err)
+			asserts.Equals(t, testCase.expected, value, "value")
+			asserts.Equals(t, testCase.str, testCase.expr.String(), "string")
+		})
+	}
+}
+
+func TestDivisionByZero(t *testing.T) {
+	_, err := Add(Value[*user](1), Div(Field(score), Field(bonus))).Eval(&user{score: 1})
+	asserts.Equals(t, true, errors.Is(err, ErrDivisionByZero), "is error")
+
+	value, err := Div(Value[*user](1.0), Value[*user](4.0)).Eval(&user{})
+	asserts.Success(t, err)
+	asserts.Equals(t, 0.25, value, "value")
+}
@@ -0,0 +1,55 @@
+package expr
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/shamcode/simd/record"
+)
+
+// Abs creates expression abs(e).
+func Abs[R record.Record, T Number](e Expr[R, T]) Expr[R, T] {
+	return function("abs", e, func(value T) T {
+		if value < 0 {
+			return -value
+		}
+
+		return value
+	})
+}
+
+// Float creates expression float(e) for mixing integer and float values in arithmetic.
+func Float[R record.Record, T Number](e Expr[R, T]) Expr[R, float64] {
+	return function("float", e, func(value T) float64 { return float64(value) })
+}
+
+// Len creates expression len(e), length of string in runes.
+func Len[R record.Record](e Expr[R, string]) Expr[R, int] {
+	return function("len", e, utf8.RuneCountInString)
+}
+
+// Lower creates expression lower(e).
+func Lower[R record.Record](e Expr[R, string]) Expr[R, string] {
+	return function("lower", e, strings.ToLower)
+}
+
+// Upper creates expression upper(e).
+func Upper[R record.Record](e Expr[R, string]) Expr[R, string] {
+	return function("upper", e, strings.ToUpper)
+}
+
+func function[R record.Record, T, V any](name string, e Expr[R, T], apply func(value T) V) Expr[R, V] {
+	return Expr[R, V]{
+		eval: func(item R) (V, error) {
+			value, err := e.eval(item)
+			if err != nil {
+				var zero V
+				return zero, err
+			}
+
+			return apply(value), nil
+		},
+		str:        name + "(" + e.str + ")",
+		precedence: precedenceOperand,
+	}
+}
@@ -0,0 +1,101 @@
+// Package fold normalizes strings for case- and accent-insensitive matching.
+//
+// String converts a string to lower case, removes combining marks and replaces
+// precomposed Latin, Greek and Cyrillic letters with diacritics by base letters,
+// so "Ærøskøbing", "ÆRØSKØBING" and "aeroskobing" are folded to the same value.
+package fold
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// table maps lower case letters with diacritics and ligatures to their base form.
+var table = buildTable(map[string]string{
+	"a":  "àáâãäåāăąǎǟǡǻȁȃȧạảấầẩẫậắằẳẵặ",
+	"ae": "æǣǽ",
+	"c":  "çćĉċč",
+	"d":  "ďđ",
+	"e":  "èéêëēĕėęěȅȇȩẹẻẽếềểễệ",
+	"g":  "ĝğġģǧǵ",
+	"h":  "ĥħ",
+	"i":  "ìíîïĩīĭįıǐȉȋỉị",
+	"ij": "ĳ",
+	"j":  "ĵ",
+	"k":  "ķǩ",
+	"l":  "ĺļľŀł",
+	"n":  "ñńņňŉǹ",
+	"o":  "òóôõöøōŏőǒǫǭǿȍȏȫȭȯȱọỏốồổỗộớờởỡợơ",
+	"oe": "œ",
+	"r":  "ŕŗřȑȓ",
+	"s":  "śŝşšș",
+	"ss": "ß",
+	"t":  "ţťŧț",
+	"th": "þ",
+	"u":  "ùúûüũūŭůűųǔǖǘǚǜȕȗụủứừửữựư",
+	"w":  "ŵẁẃẅ",
+	"y":  "ýÿŷỳỵỷỹ",
+	"z":  "źżž",
+	"α":  "ά",
+	"ε":  "έ",
+	"η":  "ή",
+	"ι":  "ίϊΐ",
+	"ο":  "ό",
+	"σ":  "ς",
+	"υ":  "ύϋΰ",
+	"ω":  "ώ",
+	"е":  "ё",
+	"и":  "й",
+})
+
+func buildTable(groups map[string]string) map[rune]string {
+	res := make(map[rune]string)
+
+	for base, letters := range groups {
+		for _, letter := range letters {
+			res[letter] = base
+		}
+	}
+
+	return res
+}
+
+// String returns case- and accent-folded string. String is idempotent: String(String(s)) == String(s).
+func String(s string) string {
+	if isFolded(s) {
+		return s
+	}
+
+	var res strings.Builder
+
+	res.Grow(len(s))
+
+	for _, r := range s {
+		if unicode.Is(unicode.Mn, r) {
+			// Combining mark of decomposed letter
+			continue
+		}
+
+		r = unicode.ToLower(r)
+
+		if replacement, ok := table[r]; ok {
+			res.WriteString(replacement)
+		} else {
+			res.WriteRune(r)
+		}
+	}
+
+	return res.String()
+}
+
+// isFolded checks fast path: ASCII string without upper case letters.
+func isFolded(s string) bool {
+	for i := range len(s) {
+		if c := s[i]; c >= utf8.RuneSelf || ('A' <= c && c <= 'Z') {
+			return false
+		}
+	}
+
+	return true
+}
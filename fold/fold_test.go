@@ -0,0 +1,32 @@
+package fold
+
+import (
+	"testing"
+
+	asserts "github.com/shamcode/assert"
+)
+
+func TestString(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected string
+	}{
+		{input: "foo@x.com", expected: "foo@x.com"},
+		{input: "Foo@X.com", expected: "foo@x.com"},
+		{input: "Crème Brûlée", expected: "creme brulee"},
+		{input: "Cre\u0300me", expected: "creme"},
+		{input: "Ærøskøbing", expected: "aeroskobing"},
+		{input: "STRAßE", expected: "strasse"},
+		{input: "Łódź", expected: "lodz"},
+		{input: "ΟΔΥΣΣΕΎΣ", expected: "οδυσσευσ"},
+		{input: "Ёлка", expected: "елка"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.input, func(t *testing.T) {
+			res := String(testCase.input)
+			asserts.Equals(t, testCase.expected, res, "folded")
+			asserts.Equals(t, res, String(res), "idempotent")
+		})
+	}
+}
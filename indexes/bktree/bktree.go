@@ -0,0 +1,151 @@
+package bktree
+
+import (
+	"github.com/shamcode/simd/distance"
+	"github.com/shamcode/simd/indexes"
+	"github.com/shamcode/simd/indexes/compute"
+	"github.com/shamcode/simd/storage"
+)
+
+// node of BK-tree, children are placed by distance to the node value. Node without records is removed,
+// it's kept in the tree while it has children, dist is a distance to the parent.
+type node struct {
+	key      indexes.Key
+	value    string
+	records  storage.IDStorage
+	parent   *node
+	dist     int
+	children map[int]*node
+}
+
+type bkTree struct {
+	metric distance.Metric
+	root   *node
+	nodes  map[string]*node
+}
+
+func keyValue(key indexes.Key) string {
+	return key.(compute.ComparableKey[string]).Value
+}
+
+func (t *bkTree) Get(key indexes.Key) storage.IDStorage {
+	if n, ok := t.nodes[keyValue(key)]; ok {
+		return n.records
+	}
+
+	return nil
+}
+
+func (t *bkTree) Set(key indexes.Key, records storage.IDStorage) {
+	value := keyValue(key)
+	if n, ok := t.nodes[value]; ok {
+		n.records = records
+		return
+	}
+
+	added := &node{
+		key:      key,
+		value:    value,
+		records:  records,
+		parent:   nil,
+		dist:     0,
+		children: nil,
+	}
+	t.nodes[value] = added
+
+	if nil == t.root {
+		t.root = added
+		return
+	}
+
+	for n := t.root; ; {
+		dist := t.metric.Distance(value, n.value)
+
+		child, ok := n.children[dist]
+		if !ok {
+			if nil == n.children {
+				n.children = make(map[int]*node)
+			}
+
+			added.parent = n
+			added.dist = dist
+			n.children[dist] = added
+
+			return
+		}
+
+		n = child
+	}
+}
+
+// Remove removes records of the key. The node stays in the tree as removed while it has children,
+// removed nodes without children are dropped.
+func (t *bkTree) Remove(key indexes.Key) {
+	n, ok := t.nodes[keyValue(key)]
+	if !ok {
+		return
+	}
+
+	n.records = nil
+
+	for nil != n && nil == n.records && len(n.children) == 0 {
+		delete(t.nodes, n.value)
+
+		if nil == n.parent {
+			t.root = nil
+		} else {
+			delete(n.parent.children, n.dist)
+		}
+
+		n = n.parent
+	}
+}
+
+func (t *bkTree) Keys() []indexes.Key {
+	keys := make([]indexes.Key, 0, len(t.nodes))
+	for _, n := range t.nodes {
+		if nil != n.records {
+			keys = append(keys, n.key)
+		}
+	}
+
+	return keys
+}
+
+// Search calls callback for keys with distance to term not greater than radius, removed keys are skipped.
+// Only subtrees, which can contain such keys by triangle inequality, are visited.
+func (t *bkTree) Search(term string, radius int, callback func(key indexes.Key, records storage.IDStorage, dist int)) {
+	if nil == t.root {
+		return
+	}
+
+	stack := []*node{t.root}
+	for len(stack) > 0 {
+		n := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		dist := t.metric.Distance(term, n.value)
+		if dist <= radius && nil != n.records {
+			callback(n.key, n.records, dist)
+		}
+
+		for childDist, child := range n.children {
+			if childDist >= dist-radius && childDist <= dist+radius {
+				stack = append(stack, child)
+			}
+		}
+	}
+}
+
+func (t *bkTree) Metric() distance.Metric {
+	return t.metric
+}
+
+// NewTree creates BK-tree for string keys (compute.ComparableKey[string]) with edit distance metric.
+func NewTree(metric distance.Metric) Storage {
+	return &bkTree{
+		metric: metric,
+		root:   nil,
+		nodes:  make(map[string]*node),
+	}
+}
@@ -0,0 +1,57 @@
+//nolint:exhaustruct
+package bktree
+
+import (
+	"slices"
+	"testing"
+
+	asserts "github.com/shamcode/assert"
+	"github.com/shamcode/simd/distance"
+	"github.com/shamcode/simd/indexes"
+	"github.com/shamcode/simd/indexes/compute"
+	"github.com/shamcode/simd/storage"
+)
+
+func TestRemove(t *testing.T) {
+	tree := NewTree(distance.Levenshtein).(*bkTree)
+
+	key := func(value string) indexes.Key {
+		return compute.ComparableKey[string]{Value: value}
+	}
+
+	for _, value := range []string{"john", "jonh", "joan", "johan"} {
+		tree.Set(key(value), storage.CreateSetIDStorage())
+	}
+
+	search := func(term string, radius int) []string {
+		var values []string
+
+		tree.Search(term, radius, func(key indexes.Key, _ storage.IDStorage, _ int) {
+			values = append(values, keyValue(key))
+		})
+
+		slices.Sort(values)
+
+		return values
+	}
+
+	asserts.Equals(t, []string{"joan", "johan", "john", "jonh"}, search("john", 2), "before remove")
+
+	// Root has children, so it's kept as removed node
+	tree.Remove(key("john"))
+	asserts.Equals(t, []string{"joan", "johan", "jonh"}, search("john", 2), "removed root")
+	asserts.Equals(t, 3, len(tree.Keys()), "keys")
+	asserts.Equals(t, nil, tree.Get(key("john")), "records of removed key")
+	asserts.Equals(t, 4, len(tree.nodes), "nodes")
+
+	for _, value := range []string{"jonh", "joan", "johan"} {
+		tree.Remove(key(value))
+	}
+
+	asserts.Equals(t, []string(nil), search("john", 2), "removed all")
+	asserts.Equals(t, 0, len(tree.nodes), "nodes after remove all")
+	asserts.Equals(t, (*node)(nil), tree.root, "root after remove all")
+
+	tree.Set(key("jon"), storage.CreateSetIDStorage())
+	asserts.Equals(t, []string{"jon"}, search("john", 1), "after set")
+}
@@ -0,0 +1,23 @@
+package bktree
+
+import (
+	"github.com/shamcode/simd/distance"
+	"github.com/shamcode/simd/indexes"
+	"github.com/shamcode/simd/indexes/compute"
+	"github.com/shamcode/simd/record"
+)
+
+// NewStringBKTreeIndex creates BK-tree index for fuzzy search (where.Fuzzy) by string field.
+// Conditions with other metric are supported, but visit more keys.
+func NewStringBKTreeIndex[R record.Record](
+	getter record.GetterInterface[R, string],
+	metric distance.Metric,
+	unique bool,
+) indexes.Index[R] {
+	return NewIndex(
+		getter,
+		compute.CreateIndexComputation(getter),
+		NewTree(metric),
+		unique,
+	)
+}
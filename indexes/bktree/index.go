@@ -0,0 +1,134 @@
+//nolint:exhaustive,nonamedreturns
+package bktree
+
+import (
+	"github.com/shamcode/simd/distance"
+	"github.com/shamcode/simd/indexes"
+	"github.com/shamcode/simd/record"
+	"github.com/shamcode/simd/storage"
+	"github.com/shamcode/simd/where"
+)
+
+type Storage interface {
+	indexes.Storage
+	Keys() []indexes.Key
+	Metric() distance.Metric
+	Search(term string, radius int, callback func(key indexes.Key, records storage.IDStorage, dist int))
+}
+
+// metricComparator is implemented by comparators of where.Fuzzy condition.
+type metricComparator interface {
+	GetMetric() distance.Metric
+}
+
+type index[R record.Record] struct {
+	field   record.Field
+	unique  bool
+	compute indexes.IndexComputer[R]
+	storage indexes.ConcurrentStorage
+}
+
+func (idx index[R]) bkTree() Storage {
+	return idx.storage.Unwrap().(Storage)
+}
+
+func (idx index[R]) Field() record.Field {
+	return idx.field
+}
+
+func (idx index[R]) Unique() bool {
+	return idx.unique
+}
+
+func (idx index[R]) Compute() indexes.IndexComputer[R] {
+	return idx.compute
+}
+
+func (idx index[R]) Weight(condition where.Condition[R]) (canApplyIndex bool, weight indexes.IndexWeight) {
+	if !indexes.FoldingMatches(idx.compute, condition) {
+		return false, 0
+	}
+
+	if _, _, ok := idx.fuzzy(condition); ok {
+		// BK-tree visits only near keys
+		return true, indexes.IndexWeightLow
+	}
+
+	if isEquality, exclude := indexes.EqualityCondition(condition); isEquality && !exclude {
+		return true, indexes.IndexWeightMedium
+	}
+
+	// For other condition index can apply, but not optimal
+	return true, indexes.IndexWeightHigh
+}
+
+// fuzzy returns term and radius of search in metric of tree for Fuzzy condition.
+func (idx index[R]) fuzzy(condition where.Condition[R]) (term string, radius int, ok bool) {
+	if condition.WithNot || condition.Cmp.GetType() != where.Fuzzy {
+		return "", 0, false
+	}
+
+	term, isString := condition.Cmp.ValueAt(0).(string)
+	maxEdits, isInt := condition.Cmp.ValueAt(1).(int)
+	metric, isMetric := condition.Cmp.(metricComparator)
+
+	if !isString || !isInt || !isMetric {
+		return "", 0, false
+	}
+
+	return keyValue(idx.compute.ForValue(term)), idx.bkTree().Metric().Radius(metric.GetMetric(), maxEdits), true
+}
+
+func (idx index[R]) Select(condition where.Condition[R]) (count int, ids []storage.IDIterator, err error) {
+	if term, radius, ok := idx.fuzzy(condition); ok {
+		var keys []indexes.Key
+
+		idx.storage.RLock()
+		idx.bkTree().Search(term, radius, func(key indexes.Key, _ storage.IDStorage, _ int) {
+			keys = append(keys, key)
+		})
+		idx.storage.RUnlock()
+
+		// Radius can be greater than max edits of condition for other metric
+		return indexes.SelectForKeys(idx.storage, idx.compute, keys, condition)
+	}
+
+	if isEquality, exclude := indexes.EqualityCondition(condition); isEquality && !exclude {
+		count, ids = indexes.SelectForValues(idx.storage, idx.compute, condition)
+		return
+	}
+
+	idx.storage.RLock()
+	keys := idx.bkTree().Keys()
+	idx.storage.RUnlock()
+
+	return indexes.SelectForKeys(idx.storage, idx.compute, keys, condition)
+}
+
+// SelectExcluded selects records with values of NE, NOT IN conditions for subtracting from all records.
+func (idx index[R]) SelectExcluded(condition where.Condition[R]) (
+	canApplyIndex bool,
+	count int,
+	ids []storage.IDIterator,
+	err error,
+) {
+	return indexes.SelectExcluded(idx.storage, idx.compute, condition)
+}
+
+func (idx index[R]) ConcurrentStorage() indexes.ConcurrentStorage {
+	return idx.storage
+}
+
+func NewIndex[R record.Record](
+	field record.Field,
+	compute indexes.IndexComputer[R],
+	bkTree Storage,
+	unique bool,
+) indexes.Index[R] {
+	return index[R]{
+		field:   field,
+		unique:  unique,
+		compute: compute,
+		storage: indexes.CreateConcurrentStorage(bkTree, unique),
+	}
+}
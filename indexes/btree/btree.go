@@ -44,7 +44,7 @@ func (node *node) search(key indexes.Key) (index int, found bool) { //nolint:non
 
 func (node *node) iterateAscend(
 	start, stop indexes.Key,
-	includeStart bool,
+	includeStart, includeStop bool,
 	hit bool,
 	iter func(e *entry),
 ) (bool, bool) {
@@ -59,7 +59,7 @@ func (node *node) iterateAscend(
 
 	for i := index; i < len(node.entries); i++ {
 		if len(node.children) > 0 {
-			if hit, ok = node.children[i].iterateAscend(start, stop, includeStart, hit, iter); !ok {
+			if hit, ok = node.children[i].iterateAscend(start, stop, includeStart, includeStop, hit, iter); !ok {
 				return hit, false
 			}
 		}
@@ -70,7 +70,7 @@ func (node *node) iterateAscend(
 		}
 
 		hit = true
-		if stop != nil && !node.entries[i].key.Less(stop) {
+		if stop != nil && isAfterStop(node.entries[i].key, stop, includeStop) {
 			return hit, false
 		}
 
@@ -78,7 +78,9 @@ func (node *node) iterateAscend(
 	}
 
 	if len(node.children) > 0 {
-		if hit, ok = node.children[len(node.children)-1].iterateAscend(start, stop, includeStart, hit, iter); !ok {
+		if hit, ok = node.children[len(node.children)-1].iterateAscend(
+			start, stop, includeStart, includeStop, hit, iter,
+		); !ok {
 			return hit, false
 		}
 	}
@@ -86,6 +88,14 @@ func (node *node) iterateAscend(
 	return hit, true
 }
 
+func isAfterStop(key, stop indexes.Key, includeStop bool) bool {
+	if includeStop {
+		return stop.Less(key)
+	}
+
+	return !key.Less(stop)
+}
+
 func (node *node) iterateDescend(
 	start, stop indexes.Key,
 	includeStart bool,
@@ -310,7 +320,7 @@ const (
 func (tree *btree) collect(
 	dir direction,
 	start, stop indexes.Key,
-	includeStart bool,
+	includeStart, includeStop bool,
 ) (int, []storage.IDIterator) {
 	if nil == tree.root {
 		return 0, nil
@@ -332,7 +342,7 @@ func (tree *btree) collect(
 
 	switch dir {
 	case ascend:
-		tree.root.iterateAscend(start, stop, includeStart, false, iter)
+		tree.root.iterateAscend(start, stop, includeStart, includeStop, false, iter)
 	case descend:
 		tree.root.iterateDescend(start, stop, includeStart, false, iter)
 	}
@@ -341,19 +351,28 @@ func (tree *btree) collect(
 }
 
 func (tree *btree) LessThan(key indexes.Key) (int, []storage.IDIterator) {
-	return tree.collect(ascend, nil, key, false)
+	return tree.collect(ascend, nil, key, false, false)
 }
 
 func (tree *btree) LessOrEqual(key indexes.Key) (int, []storage.IDIterator) {
-	return tree.collect(descend, key, nil, true)
+	return tree.collect(descend, key, nil, true, false)
 }
 
 func (tree *btree) GreaterThan(key indexes.Key) (int, []storage.IDIterator) {
-	return tree.collect(descend, nil, key, false)
+	return tree.collect(descend, nil, key, false, false)
 }
 
 func (tree *btree) GreaterOrEqual(key indexes.Key) (int, []storage.IDIterator) {
-	return tree.collect(ascend, key, nil, true)
+	return tree.collect(ascend, key, nil, true, false)
+}
+
+// Range walks once from low to high, include flags define inclusion of bounds.
+func (tree *btree) Range(low, high indexes.Key, includeLow, includeHigh bool) (int, []storage.IDIterator) {
+	if high.Less(low) {
+		return 0, nil
+	}
+
+	return tree.collect(ascend, low, high, includeLow, includeHigh)
 }
 
 func (tree *btree) All(iter func(key indexes.Key, records storage.IDStorage)) {
@@ -361,7 +380,7 @@ func (tree *btree) All(iter func(key indexes.Key, records storage.IDStorage)) {
 		return
 	}
 
-	tree.root.iterateAscend(nil, nil, false, false, func(e *entry) {
+	tree.root.iterateAscend(nil, nil, false, false, false, func(e *entry) {
 		iter(e.key, e.records)
 	})
 }
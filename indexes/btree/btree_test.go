@@ -124,4 +124,30 @@ func TestBTree(t *testing.T) {
 			asserts.Equals(t, testCase.expectedIDS, concatIDs(ids), fmt.Sprintf("check _int64 for %d", testCase.key))
 		}
 	})
+
+	t.Run("Range", func(t *testing.T) {
+		for low := -1; low <= n+1; low++ {
+			for high := low - 1; high <= n+1; high++ {
+				for _, include := range [][2]bool{{true, true}, {true, false}, {false, true}, {false, false}} {
+					var expectedIDS []int
+
+					for i := 1; i <= n; i++ {
+						if (i > low || include[0] && i == low) && (i < high || include[1] && i == high) {
+							expectedIDS = append(expectedIDS, i)
+						}
+					}
+
+					count, ids := tree.Range(
+						compute.ComparableKey[int]{Value: low},
+						compute.ComparableKey[int]{Value: high},
+						include[0],
+						include[1],
+					)
+					name := fmt.Sprintf("%d..%d %v", low, high, include)
+					asserts.Equals(t, len(expectedIDS), count, "check count for "+name)
+					asserts.Equals(t, expectedIDS, concatIDs(ids), "check ids for "+name)
+				}
+			}
+		}
+	})
 }
@@ -1,6 +1,8 @@
 package btree
 
 import (
+	"time"
+
 	"github.com/shamcode/simd/indexes"
 	"github.com/shamcode/simd/indexes/compute"
 	"github.com/shamcode/simd/record"
@@ -18,3 +20,47 @@ func NewComparableBTreeIndex[R record.Record, V record.LessComparable](
 		uniq,
 	)
 }
+
+// NewNullableBTreeIndex creates B-tree index for nullable field, NULL values are stored in a dedicated key,
+// which is less than any value.
+func NewNullableBTreeIndex[R record.Record, T record.LessComparable](
+	getter record.NullableGetter[R, T],
+	maxChildren int,
+	uniq bool,
+) indexes.Index[R] {
+	return NewIndex[R](
+		getter.Field,
+		compute.CreateNullableIndexComputation(getter),
+		NewTree(maxChildren, uniq),
+		uniq,
+	)
+}
+
+// NewFoldedStringBTreeIndex creates B-tree index with case- and accent-folded keys for conditions of query.FieldFolded.
+func NewFoldedStringBTreeIndex[R record.Record](
+	getter record.GetterInterface[R, string],
+	maxChildren int,
+	uniq bool,
+) indexes.Index[R] {
+	return NewIndex[R](
+		getter,
+		compute.CreateFoldedStringIndexComputation(getter),
+		NewTree(maxChildren, uniq),
+		uniq,
+	)
+}
+
+// NewTimeBTreeIndex creates B-tree index for time.Time field, keys are instants of time, so values
+// in any location are found.
+func NewTimeBTreeIndex[R record.Record](
+	getter record.GetterInterface[R, time.Time],
+	maxChildren int,
+	uniq bool,
+) indexes.Index[R] {
+	return NewIndex[R](
+		getter,
+		compute.CreateTimeIndexComputation(getter),
+		NewTree(maxChildren, uniq),
+		uniq,
+	)
+}
@@ -14,6 +14,7 @@ type Storage interface {
 	LessOrEqual(key indexes.Key) (int, []storage.IDIterator)
 	GreaterThan(key indexes.Key) (int, []storage.IDIterator)
 	GreaterOrEqual(key indexes.Key) (int, []storage.IDIterator)
+	Range(low, high indexes.Key, includeLow, includeHigh bool) (int, []storage.IDIterator)
 	ForKey(key indexes.Key) (int, storage.IDIterator)
 	All(callback func(key indexes.Key, records storage.IDStorage))
 }
@@ -42,13 +43,29 @@ func (idx index[R]) Compute() indexes.IndexComputer[R] {
 }
 
 func (idx index[R]) Weight(condition where.Condition[R]) (canApplyIndex bool, weight indexes.IndexWeight) {
+	if !indexes.FoldingMatches(idx.compute, condition) {
+		return false, 0
+	}
+
 	switch condition.Cmp.GetType() {
 	case where.LT, where.LE, where.GT, where.GE:
 		// B-tree optimal for <, <=, >, >=
 		return true, indexes.IndexWeightLow
-	case where.EQ, where.InArray:
+	case where.IsNull, where.IsNotNull:
+		if _, exclude, ok := indexes.NullCondition(idx.compute, condition); ok && !exclude {
+			return true, indexes.IndexWeightMedium
+		}
+
+		return true, indexes.IndexWeightHigh
+	case where.Between:
 		if condition.WithNot {
 			return true, indexes.IndexWeightHigh
+		}
+
+		return true, indexes.IndexWeightLow
+	case where.EQ, where.InArray, where.NE, where.NotInArray:
+		if _, exclude := indexes.EqualityCondition(condition); exclude {
+			return true, indexes.IndexWeightHigh
 		} else {
 			// Hash index more optimal for A == 1 and A in (1, 2, 3)
 			return true, indexes.IndexWeightMedium
@@ -64,76 +81,43 @@ func (idx index[R]) Select(condition where.Condition[R]) ( //nolint:cyclop
 	ids []storage.IDIterator,
 	err error,
 ) {
-	cmp := condition.Cmp.GetType()
-	if condition.WithNot {
-		switch cmp {
-		case where.LT:
-			cmp = where.GE
-		case where.LE:
-			cmp = where.GT
-		case where.GT:
-			cmp = where.LE
-		case where.GE:
-			cmp = where.LT
+	_, isNullable := idx.compute.(indexes.NullableComputer)
+
+	// NOT of comparison with NULL is true, so inverted range doesn't contain NULL: use scan for nullable field
+	if !condition.WithNot || !isNullable {
+		var ok bool
+		if count, ids, ok = idx.selectRange(condition); ok {
+			return
 		}
 	}
 
-	switch cmp {
-	case where.LT:
-		idx.storage.RLock()
-		count, ids = idx.btree().LessThan(idx.compute.ForValue(condition.Cmp.ValueAt(0)))
-		idx.storage.RUnlock()
-
-		return
-	case where.LE:
-		idx.storage.RLock()
-		count, ids = idx.btree().LessOrEqual(idx.compute.ForValue(condition.Cmp.ValueAt(0)))
-		idx.storage.RUnlock()
+	if isEquality, exclude := indexes.EqualityCondition(condition); isEquality && !exclude {
+		count, ids = idx.selectForValues(condition)
 
 		return
-	case where.GT:
-		idx.storage.RLock()
-		count, ids = idx.btree().GreaterThan(idx.compute.ForValue(condition.Cmp.ValueAt(0)))
-		idx.storage.RUnlock()
+	}
 
-		return
-	case where.GE:
+	if key, exclude, ok := indexes.NullCondition(idx.compute, condition); ok && !exclude {
 		idx.storage.RLock()
-		count, ids = idx.btree().GreaterOrEqual(idx.compute.ForValue(condition.Cmp.ValueAt(0)))
+		count, ids = idx.selectForKey(key)
 		idx.storage.RUnlock()
 
 		return
 	}
 
-	if !condition.WithNot {
-		switch cmp {
-		case where.EQ:
-			idx.storage.RLock()
-			countForKey, idsForKey := idx.btree().ForKey(idx.compute.ForValue(condition.Cmp.ValueAt(0)))
-			idx.storage.RUnlock()
-
-			if countForKey > 0 {
-				count = countForKey
-				ids = []storage.IDIterator{idsForKey}
-			}
-
-			return
-		case where.InArray:
-			idx.storage.RLock()
+	if !condition.WithNot && condition.Cmp.GetType() == where.Between {
+		bounds := where.BoundsOf(condition.Cmp)
 
-			for i := range condition.Cmp.ValuesCount() {
-				countForValue, idsForValue := idx.btree().ForKey(idx.compute.ForValue(condition.Cmp.ValueAt(i)))
-				if countForValue > 0 {
-					count += countForValue
-
-					ids = append(ids, idsForValue)
-				}
-			}
-
-			idx.storage.RUnlock()
+		idx.storage.RLock()
+		count, ids = idx.btree().Range(
+			idx.compute.ForValue(condition.Cmp.ValueAt(0)),
+			idx.compute.ForValue(condition.Cmp.ValueAt(1)),
+			bounds.IncludeLow(),
+			bounds.IncludeHigh(),
+		)
+		idx.storage.RUnlock()
 
-			return
-		}
+		return
 	}
 
 	idx.storage.RLock()
@@ -153,11 +137,113 @@ func (idx index[R]) Select(condition where.Condition[R]) ( //nolint:cyclop
 			}
 		}
 	})
-	idx.storage.RLock()
+	idx.storage.RUnlock()
 
 	return //nolint:nakedret
 }
 
+// selectRange selects records for <, <=, >, >= conditions, NOT inverts condition.
+func (idx index[R]) selectRange(condition where.Condition[R]) (count int, ids []storage.IDIterator, ok bool) {
+	cmp := condition.Cmp.GetType()
+	if condition.WithNot {
+		switch cmp {
+		case where.LT:
+			cmp = where.GE
+		case where.LE:
+			cmp = where.GT
+		case where.GT:
+			cmp = where.LE
+		case where.GE:
+			cmp = where.LT
+		}
+	}
+
+	idx.storage.RLock()
+	defer idx.storage.RUnlock()
+
+	nullable, isNullable := idx.compute.(indexes.NullableComputer)
+
+	switch cmp {
+	case where.LT:
+		if isNullable {
+			// NULL is less than any value, but doesn't match
+			count, ids = idx.btree().Range(nullable.ForNull(), idx.compute.ForValue(condition.Cmp.ValueAt(0)), false, false)
+		} else {
+			count, ids = idx.btree().LessThan(idx.compute.ForValue(condition.Cmp.ValueAt(0)))
+		}
+	case where.LE:
+		if isNullable {
+			count, ids = idx.btree().Range(nullable.ForNull(), idx.compute.ForValue(condition.Cmp.ValueAt(0)), false, true)
+		} else {
+			count, ids = idx.btree().LessOrEqual(idx.compute.ForValue(condition.Cmp.ValueAt(0)))
+		}
+	case where.GT:
+		count, ids = idx.btree().GreaterThan(idx.compute.ForValue(condition.Cmp.ValueAt(0)))
+	case where.GE:
+		count, ids = idx.btree().GreaterOrEqual(idx.compute.ForValue(condition.Cmp.ValueAt(0)))
+	default:
+		return 0, nil, false
+	}
+
+	return count, ids, true
+}
+
+// SelectExcluded selects records with values of NE, NOT IN conditions for subtracting from all records.
+func (idx index[R]) SelectExcluded(condition where.Condition[R]) (
+	canApplyIndex bool,
+	count int,
+	ids []storage.IDIterator,
+	err error,
+) {
+	if !indexes.FoldingMatches(idx.compute, condition) {
+		return false, 0, nil, nil
+	}
+
+	if key, exclude, ok := indexes.NullCondition(idx.compute, condition); ok {
+		if !exclude {
+			return false, 0, nil, nil
+		}
+
+		idx.storage.RLock()
+		count, ids = idx.selectForKey(key)
+		idx.storage.RUnlock()
+
+		return true, count, ids, nil
+	}
+
+	if isEquality, exclude := indexes.EqualityCondition(condition); !isEquality || !exclude {
+		return false, 0, nil, nil
+	}
+
+	count, ids = idx.selectForValues(condition)
+
+	return true, count, ids, nil
+}
+
+func (idx index[R]) selectForValues(condition where.Condition[R]) (count int, ids []storage.IDIterator) {
+	idx.storage.RLock()
+	defer idx.storage.RUnlock()
+
+	for key := range indexes.ValueKeys(idx.compute, condition.Cmp) {
+		countForValue, idsForValue := idx.selectForKey(key)
+		count += countForValue
+
+		ids = append(ids, idsForValue...)
+	}
+
+	return
+}
+
+// selectForKey selects records by key, storage must be locked.
+func (idx index[R]) selectForKey(key indexes.Key) (count int, ids []storage.IDIterator) {
+	count, idsForKey := idx.btree().ForKey(key)
+	if count > 0 {
+		ids = []storage.IDIterator{idsForKey}
+	}
+
+	return
+}
+
 func (idx index[R]) ConcurrentStorage() indexes.ConcurrentStorage {
 	return idx.storage
 }
@@ -79,6 +79,17 @@ func TestIndex(t *testing.T) {
 				expectedCanApply: true,
 				expectedWeight:   indexes.IndexWeightHigh,
 			},
+			{
+				condition: where.Condition[record.Record]{
+					Cmp: comparators.NewComparableFieldComparator[record.Record, int64](
+						where.Between,
+						_id,
+						1, 5,
+					),
+				},
+				expectedCanApply: true,
+				expectedWeight:   indexes.IndexWeightLow,
+			},
 		}
 
 		for _, test := range testCases {
@@ -176,6 +187,39 @@ func TestIndex(t *testing.T) {
 				expectedCount: 9,
 				expectedIDs:   []int64{1, 2, 3, 4, 6, 7, 8, 9, 10},
 			},
+			{
+				condition: where.Condition[record.Record]{
+					Cmp: comparators.NewComparableFieldComparator[record.Record, int64](
+						where.Between,
+						_id,
+						3, 5,
+					),
+				},
+				expectedCount: 3,
+				expectedIDs:   []int64{3, 4, 5},
+			},
+			{
+				condition: where.Condition[record.Record]{
+					Cmp: comparators.ComparableFieldComparator[record.Record, int64]{
+						EqualComparator: comparators.NewEqualComparator[record.Record, int64](where.Between, _id, 3, 6),
+						Bounds:          where.Exclusive,
+					},
+				},
+				expectedCount: 2,
+				expectedIDs:   []int64{4, 5},
+			},
+			{
+				condition: where.Condition[record.Record]{
+					WithNot: true,
+					Cmp: comparators.NewComparableFieldComparator[record.Record, int64](
+						where.Between,
+						_id,
+						2, 9,
+					),
+				},
+				expectedCount: 2,
+				expectedIDs:   []int64{1, 10},
+			},
 		}
 
 		for _, test := range testCases {
@@ -200,3 +244,93 @@ func TestIndex(t *testing.T) {
 		}
 	})
 }
+
+func TestNullableIndex(t *testing.T) {
+	values := map[int64]int64{2: 10, 3: 20, 5: 30, 6: 10}
+	getter := record.NullableGetter[record.Record, int64]{
+		Field: record.NewFields().New("value"),
+		Get: func(item record.Record) (int64, bool) {
+			value, ok := values[item.GetID()]
+			return value, ok
+		},
+	}
+	index := NewNullableBTreeIndex(getter, 8, false)
+
+	var id int64
+	for id = 1; id <= 6; id++ {
+		key := index.Compute().ForValue(nil)
+		if value, ok := values[id]; ok {
+			key = index.Compute().ForValue(value)
+		}
+
+		index.ConcurrentStorage().GetOrCreate(key).Add(id)
+	}
+
+	condition := func(withNot bool, cmp where.ComparatorType, value ...int64) where.Condition[record.Record] {
+		return where.Condition[record.Record]{
+			WithNot: withNot,
+			Cmp:     comparators.NewNullableFieldComparator(cmp, getter, value...),
+		}
+	}
+
+	testCases := []struct {
+		condition   where.Condition[record.Record]
+		expectedIDs []int64
+	}{
+		{
+			condition:   condition(false, where.IsNull),
+			expectedIDs: []int64{1, 4},
+		},
+		{
+			condition:   condition(false, where.IsNotNull),
+			expectedIDs: []int64{2, 3, 5, 6},
+		},
+		{
+			condition:   condition(true, where.IsNull),
+			expectedIDs: []int64{2, 3, 5, 6},
+		},
+		{
+			condition:   condition(false, where.EQ, 10),
+			expectedIDs: []int64{2, 6},
+		},
+		{
+			condition:   condition(false, where.LT, 25),
+			expectedIDs: []int64{2, 3, 6},
+		},
+		{
+			condition:   condition(false, where.LE, 10),
+			expectedIDs: []int64{2, 6},
+		},
+		{
+			condition:   condition(true, where.LT, 25),
+			expectedIDs: []int64{1, 4, 5},
+		},
+		{
+			condition:   condition(false, where.GE, 20),
+			expectedIDs: []int64{3, 5},
+		},
+		{
+			condition:   condition(false, where.Between, 10, 20),
+			expectedIDs: []int64{2, 3, 6},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.condition.String(), func(t *testing.T) {
+			count, idsStorage, err := index.Select(test.condition)
+			asserts.Success(t, err)
+
+			var ids []int64
+
+			for _, store := range idsStorage {
+				store.Iterate(func(id int64) {
+					ids = append(ids, id)
+				})
+			}
+
+			sort.Sort(_int64(ids))
+			asserts.Equals(t, test.expectedIDs, ids, "ids")
+			asserts.Equals(t, len(test.expectedIDs), count, "count")
+		})
+	}
+}
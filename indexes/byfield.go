@@ -18,9 +18,15 @@ type ByField[R record.Record] interface {
 		idsUnique bool,
 		err error,
 	)
+	SelectExcludedForCondition(condition where.Condition[R]) (
+		indexExists bool,
+		count int,
+		ids []storage.IDIterator,
+		err error,
+	)
 }
 
-type byField[R record.Record] map[uint8][]Index[R]
+type byField[R record.Record] map[uint16][]Index[R]
 
 func (ibf byField[R]) Add(index Index[R]) {
 	i := index.Field().Index()
@@ -30,8 +36,9 @@ func (ibf byField[R]) Add(index Index[R]) {
 func (ibf byField[R]) Insert(item R) {
 	for _, indexesForField := range ibf {
 		for _, idx := range indexesForField {
-			key := idx.Compute().ForRecord(item)
-			idx.ConcurrentStorage().GetOrCreate(key).Add(item.GetID())
+			for _, key := range KeysForRecord(idx.Compute(), item) {
+				idx.ConcurrentStorage().GetOrCreate(key).Add(item.GetID())
+			}
 		}
 	}
 }
@@ -39,9 +46,8 @@ func (ibf byField[R]) Insert(item R) {
 func (ibf byField[R]) Delete(item R) {
 	for _, indexesForField := range ibf {
 		for _, idx := range indexesForField {
-			records := idx.ConcurrentStorage().Get(idx.Compute().ForRecord(item))
-			if nil != records {
-				records.Delete(item.GetID())
+			for _, key := range KeysForRecord(idx.Compute(), item) {
+				idx.ConcurrentStorage().Delete(key, item.GetID())
 			}
 		}
 	}
@@ -50,6 +56,11 @@ func (ibf byField[R]) Delete(item R) {
 func (ibf byField[R]) Update(oldItem, item R) {
 	for _, indexesForField := range ibf {
 		for _, idx := range indexesForField {
+			if multi, ok := idx.Compute().(MultiKeyComputer[R]); ok {
+				updateKeys(idx, multi.ForRecordKeys(oldItem), multi.ForRecordKeys(item), item.GetID())
+				continue
+			}
+
 			oldValue := idx.Compute().ForRecord(oldItem)
 			newValue := idx.Compute().ForRecord(item)
 
@@ -62,10 +73,7 @@ func (ibf byField[R]) Update(oldItem, item R) {
 			}
 
 			// Remove old item from index
-			oldRecords := idx.ConcurrentStorage().Get(oldValue)
-			if nil != oldRecords {
-				oldRecords.Delete(item.GetID())
-			}
+			idx.ConcurrentStorage().Delete(oldValue, item.GetID())
 
 			// Add new item to index
 			idx.ConcurrentStorage().GetOrCreate(newValue).Add(item.GetID())
@@ -73,6 +81,27 @@ func (ibf byField[R]) Update(oldItem, item R) {
 	}
 }
 
+// updateKeys removes record from keys, which are absent in new keys, and adds record to new keys.
+func updateKeys[R record.Record](idx Index[R], oldKeys, newKeys []Key, id int64) {
+	actual := make(map[Key]struct{}, len(newKeys))
+	for _, key := range newKeys {
+		actual[key] = struct{}{}
+	}
+
+	for _, key := range oldKeys {
+		if _, ok := actual[key]; ok {
+			delete(actual, key)
+			continue
+		}
+
+		idx.ConcurrentStorage().Delete(key, id)
+	}
+
+	for key := range actual {
+		idx.ConcurrentStorage().GetOrCreate(key).Add(id)
+	}
+}
+
 func (ibf byField[R]) SelectForCondition(condition where.Condition[R]) ( //nolint:nonamedreturns
 	indexExists bool,
 	count int,
@@ -80,6 +109,11 @@ func (ibf byField[R]) SelectForCondition(condition where.Condition[R]) ( //nolin
 	idsUnique bool,
 	err error,
 ) {
+	if where.IsFieldToField(condition.Cmp) || where.IsComputed(condition.Cmp) {
+		// Value of condition depends on record, only full scan
+		return
+	}
+
 	var indexes []Index[R]
 
 	indexes, indexExists = ibf[condition.Cmp.GetField().Index()]
@@ -117,6 +151,32 @@ func (ibf byField[R]) SelectForCondition(condition where.Condition[R]) ( //nolin
 	return //nolint:nakedret
 }
 
+// SelectExcludedForCondition selects IDs of records, which don't match the condition, by index with ExcludeSelector.
+func (ibf byField[R]) SelectExcludedForCondition(condition where.Condition[R]) ( //nolint:nonamedreturns
+	indexExists bool,
+	count int,
+	ids []storage.IDIterator,
+	err error,
+) {
+	if where.IsFieldToField(condition.Cmp) || where.IsComputed(condition.Cmp) {
+		return
+	}
+
+	for _, index := range ibf[condition.Cmp.GetField().Index()] {
+		selector, ok := index.(ExcludeSelector[R])
+		if !ok {
+			continue
+		}
+
+		indexExists, count, ids, err = selector.SelectExcluded(condition)
+		if indexExists || err != nil {
+			return
+		}
+	}
+
+	return //nolint:nakedret
+}
+
 func CreateByField[R record.Record]() ByField[R] {
 	return make(byField[R])
 }
@@ -0,0 +1,128 @@
+package compute
+
+import (
+	"iter"
+	"slices"
+
+	"github.com/shamcode/simd/indexes"
+	"github.com/shamcode/simd/record"
+	"github.com/shamcode/simd/where"
+)
+
+type setComparator[T comparable] interface {
+	CompareValue(value record.Set[T]) (bool, error)
+}
+
+type sliceComparator[T comparable] interface {
+	CompareValue(value []T) (bool, error)
+}
+
+// elementsIndexComputation computes key for every element of multi-valued field.
+type elementsIndexComputation[R record.Record, T record.LessComparable] struct {
+	elements func(item R) iter.Seq[T]
+	// anyOf is types of conditions matched by any element, allOf by all elements
+	anyOf []where.ComparatorType
+	allOf []where.ComparatorType
+}
+
+// ForRecord isn't used for multi-valued field, records are posted by ForRecordKeys.
+func (idx elementsIndexComputation[R, T]) ForRecord(R) indexes.Key {
+	return nil
+}
+
+func (idx elementsIndexComputation[R, T]) ForRecordKeys(item R) []indexes.Key {
+	var keys []indexes.Key
+
+	seen := make(map[T]struct{})
+	for value := range idx.elements(item) {
+		if _, ok := seen[value]; ok {
+			continue
+		}
+
+		seen[value] = struct{}{}
+		keys = append(keys, ComparableKey[T]{Value: value})
+	}
+
+	return keys
+}
+
+func (idx elementsIndexComputation[R, T]) ForCondition(
+	comparator where.FieldComparator[R],
+) ([]indexes.Key, bool, bool) {
+	all := slices.Contains(idx.allOf, comparator.GetType())
+	if !all && !slices.Contains(idx.anyOf, comparator.GetType()) {
+		return nil, false, false
+	}
+
+	count := comparator.ValuesCount()
+	if all && count == 0 {
+		// Without values matches all records, including records without elements
+		return nil, false, false
+	}
+
+	keys := make([]indexes.Key, count)
+
+	for i := range count {
+		value, ok := comparator.ValueAt(i).(T)
+		if !ok {
+			return nil, false, false
+		}
+
+		keys[i] = ComparableKey[T]{Value: value}
+	}
+
+	return keys, all, true
+}
+
+func (idx elementsIndexComputation[R, T]) ForValue(value any) indexes.Key {
+	return ComparableKey[T]{
+		Value: value.(T),
+	}
+}
+
+// Check compares set or slice with single element of the key.
+func (idx elementsIndexComputation[R, T]) Check(
+	indexKey indexes.Key,
+	comparator where.FieldComparator[R],
+) (bool, error) {
+	value := indexKey.(ComparableKey[T]).Value
+
+	if cmp, ok := comparator.(sliceComparator[T]); ok {
+		return cmp.CompareValue([]T{value})
+	}
+
+	return comparator.(setComparator[T]).CompareValue(record.NewMapSet(value))
+}
+
+// CreateSetIndexComputation creates computation with key for every element of set.
+func CreateSetIndexComputation[
+	R record.Record,
+	T record.LessComparable,
+](getter record.SetGetter[R, T]) indexes.MultiKeyComputer[R] {
+	return elementsIndexComputation[R, T]{
+		elements: func(item R) iter.Seq[T] {
+			set := getter.Get(item)
+			if nil == set {
+				return func(func(T) bool) {}
+			}
+
+			return set.All()
+		},
+		anyOf: []where.ComparatorType{where.SetHas, where.SetHasAny},
+		allOf: []where.ComparatorType{where.SetHasAll},
+	}
+}
+
+// CreateSliceIndexComputation creates computation with key for every distinct element of slice.
+func CreateSliceIndexComputation[
+	R record.Record,
+	T record.LessComparable,
+](getter record.SliceGetter[R, T]) indexes.MultiKeyComputer[R] {
+	return elementsIndexComputation[R, T]{
+		elements: func(item R) iter.Seq[T] {
+			return slices.Values(getter.Get(item))
+		},
+		anyOf: []where.ComparatorType{where.SliceContains},
+		allOf: []where.ComparatorType{where.SliceContainsAll},
+	}
+}
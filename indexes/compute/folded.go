@@ -0,0 +1,43 @@
+package compute
+
+import (
+	"github.com/shamcode/simd/fold"
+	"github.com/shamcode/simd/indexes"
+	"github.com/shamcode/simd/record"
+	"github.com/shamcode/simd/where"
+)
+
+type foldedStringIndexComputation[R record.Record] struct {
+	getter record.GetterInterface[R, string]
+}
+
+func (idx foldedStringIndexComputation[R]) ForRecord(item R) indexes.Key {
+	return ComparableKey[string]{
+		Value: fold.String(idx.getter.GetForRecord(item)),
+	}
+}
+
+func (idx foldedStringIndexComputation[R]) ForValue(value any) indexes.Key {
+	return ComparableKey[string]{
+		Value: fold.String(value.(string)),
+	}
+}
+
+// IsFolding returns true: keys are folded values.
+func (idx foldedStringIndexComputation[R]) IsFolding() bool {
+	return true
+}
+
+func (idx foldedStringIndexComputation[R]) Check(
+	indexKey indexes.Key,
+	comparator where.FieldComparator[R],
+) (bool, error) {
+	return comparator.(lessComparableComparator[string]).CompareValue(indexKey.(ComparableKey[string]).Value)
+}
+
+// CreateFoldedStringIndexComputation creates computation with case- and accent-folded keys.
+func CreateFoldedStringIndexComputation[R record.Record](
+	getter record.GetterInterface[R, string],
+) indexes.IndexComputer[R] {
+	return foldedStringIndexComputation[R]{getter: getter}
+}
@@ -0,0 +1,138 @@
+package compute
+
+import (
+	"github.com/shamcode/simd/indexes"
+	"github.com/shamcode/simd/record"
+	"github.com/shamcode/simd/where"
+)
+
+// PairKey is a key of map entry.
+type PairKey[K record.LessComparable, V record.LessComparable] struct {
+	Key   K
+	Value V
+}
+
+func (i PairKey[K, V]) Less(than indexes.Key) bool {
+	other := than.(PairKey[K, V])
+	if i.Key != other.Key {
+		return i.Key < other.Key
+	}
+
+	return i.Value < other.Value
+}
+
+type mapComparator[K comparable, V any] interface {
+	CompareValue(value record.Map[K, V]) (bool, error)
+}
+
+// mapIndexComputation computes key for every key of map and optionally for every entry.
+type mapIndexComputation[R record.Record, K record.LessComparable, V any] struct {
+	getter record.MapGetter[R, K, V]
+	pair   func(key K, value V) indexes.Key
+	unpair func(pair indexes.Key) (K, V)
+}
+
+// ForRecord isn't used for map field, records are posted by ForRecordKeys.
+func (idx mapIndexComputation[R, K, V]) ForRecord(R) indexes.Key {
+	return nil
+}
+
+// ForRecordKeys lists entries of map by record.MapEntries, which must be implemented by values of the field.
+func (idx mapIndexComputation[R, K, V]) ForRecordKeys(item R) []indexes.Key {
+	m := idx.getter.Get(item)
+	if nil == m {
+		return nil
+	}
+
+	var keys []indexes.Key
+
+	for key, value := range m.(record.MapEntries[K, V]).All() {
+		keys = append(keys, ComparableKey[K]{Value: key})
+
+		if nil != idx.pair {
+			keys = append(keys, idx.pair(key, value))
+		}
+	}
+
+	return keys
+}
+
+func (idx mapIndexComputation[R, K, V]) ForCondition(
+	comparator where.FieldComparator[R],
+) ([]indexes.Key, bool, bool) {
+	switch comparator.GetType() { //nolint:exhaustive
+	case where.MapHasKey, where.MapKeyValueEQ:
+	default:
+		return nil, false, false
+	}
+
+	key, ok := comparator.ValueAt(0).(K)
+	if !ok {
+		return nil, false, false
+	}
+
+	if comparator.GetType() == where.MapKeyValueEQ && nil != idx.pair {
+		value, ok := comparator.ValueAt(1).(V)
+		if !ok {
+			return nil, false, false
+		}
+
+		return []indexes.Key{idx.pair(key, value)}, false, true
+	}
+
+	// Without entries records with the key are selected, value is checked by condition later
+	return []indexes.Key{ComparableKey[K]{Value: key}}, false, true
+}
+
+func (idx mapIndexComputation[R, K, V]) ForValue(value any) indexes.Key {
+	return ComparableKey[K]{
+		Value: value.(K),
+	}
+}
+
+// Check compares map with single entry of the key, value of entry is zero for key of map key.
+func (idx mapIndexComputation[R, K, V]) Check(
+	indexKey indexes.Key,
+	comparator where.FieldComparator[R],
+) (bool, error) {
+	if key, ok := indexKey.(ComparableKey[K]); ok {
+		var zero V
+
+		return comparator.(mapComparator[K, V]).CompareValue(record.MapOf[K, V]{key.Value: zero})
+	}
+
+	key, value := idx.unpair(indexKey)
+
+	return comparator.(mapComparator[K, V]).CompareValue(record.MapOf[K, V]{key: value})
+}
+
+// CreateMapKeysIndexComputation creates computation with key for every key of map.
+func CreateMapKeysIndexComputation[
+	R record.Record,
+	K record.LessComparable,
+	V any,
+](getter record.MapGetter[R, K, V]) indexes.MultiKeyComputer[R] {
+	return mapIndexComputation[R, K, V]{
+		getter: getter,
+		pair:   nil,
+		unpair: nil,
+	}
+}
+
+// CreateMapIndexComputation creates computation with key for every key and every entry of map.
+func CreateMapIndexComputation[
+	R record.Record,
+	K record.LessComparable,
+	V record.LessComparable,
+](getter record.MapGetter[R, K, V]) indexes.MultiKeyComputer[R] {
+	return mapIndexComputation[R, K, V]{
+		getter: getter,
+		pair: func(key K, value V) indexes.Key {
+			return PairKey[K, V]{Key: key, Value: value}
+		},
+		unpair: func(pair indexes.Key) (K, V) {
+			entry := pair.(PairKey[K, V])
+			return entry.Key, entry.Value
+		},
+	}
+}
@@ -0,0 +1,71 @@
+package compute
+
+import (
+	"github.com/shamcode/simd/indexes"
+	"github.com/shamcode/simd/record"
+	"github.com/shamcode/simd/where"
+)
+
+// NullableKey is a key of nullable field, NULL is less than any value.
+type NullableKey[T record.LessComparable] struct {
+	Value T
+	Valid bool
+}
+
+func (i NullableKey[T]) Less(than indexes.Key) bool {
+	other := than.(NullableKey[T])
+	if !i.Valid || !other.Valid {
+		return !i.Valid && other.Valid
+	}
+
+	return i.Value < other.Value
+}
+
+type nullableComparator[T record.LessComparable] interface {
+	CompareNullable(value T, valid bool) (bool, error)
+}
+
+type nullableIndexComputation[R record.Record, T record.LessComparable] struct {
+	getter record.NullableGetter[R, T]
+}
+
+func (idx nullableIndexComputation[R, T]) ForRecord(item R) indexes.Key {
+	value, valid := idx.getter.Get(item)
+
+	return NullableKey[T]{
+		Value: value,
+		Valid: valid,
+	}
+}
+
+func (idx nullableIndexComputation[R, T]) ForValue(value any) indexes.Key {
+	if nil == value {
+		return idx.ForNull()
+	}
+
+	return NullableKey[T]{
+		Value: value.(T),
+		Valid: true,
+	}
+}
+
+// ForNull returns key of dedicated posting list for NULL.
+func (idx nullableIndexComputation[R, T]) ForNull() indexes.Key {
+	return NullableKey[T]{} //nolint:exhaustruct
+}
+
+func (idx nullableIndexComputation[R, T]) Check(
+	indexKey indexes.Key,
+	comparator where.FieldComparator[R],
+) (bool, error) {
+	key := indexKey.(NullableKey[T])
+
+	return comparator.(nullableComparator[T]).CompareNullable(key.Value, key.Valid)
+}
+
+func CreateNullableIndexComputation[
+	R record.Record,
+	T record.LessComparable,
+](getter record.NullableGetter[R, T]) indexes.IndexComputer[R] {
+	return nullableIndexComputation[R, T]{getter: getter}
+}
@@ -0,0 +1,67 @@
+package compute
+
+import (
+	"time"
+
+	"github.com/shamcode/simd/indexes"
+	"github.com/shamcode/simd/record"
+	"github.com/shamcode/simd/where"
+)
+
+// TimeKey is a key of time.Time field, the key is the instant of time without location and monotonic
+// clock reading, so the same instants in different locations have the same key.
+type TimeKey struct {
+	Seconds int64
+	Nanos   int32
+}
+
+// NewTimeKey creates key for the instant of time, unlike time.Time.UnixNano all times are representable.
+func NewTimeKey(t time.Time) TimeKey {
+	return TimeKey{
+		Seconds: t.Unix(),
+		Nanos:   int32(t.Nanosecond()), //nolint:gosec
+	}
+}
+
+// Time returns the instant of the key in UTC.
+func (i TimeKey) Time() time.Time {
+	return time.Unix(i.Seconds, int64(i.Nanos)).UTC()
+}
+
+func (i TimeKey) Less(than indexes.Key) bool {
+	other := than.(TimeKey)
+	if i.Seconds != other.Seconds {
+		return i.Seconds < other.Seconds
+	}
+
+	return i.Nanos < other.Nanos
+}
+
+type timeComparator interface {
+	CompareValue(value time.Time) (bool, error)
+}
+
+type timeIndexComputation[R record.Record] struct {
+	getter record.GetterInterface[R, time.Time]
+}
+
+func (idx timeIndexComputation[R]) ForRecord(item R) indexes.Key {
+	return NewTimeKey(idx.getter.GetForRecord(item))
+}
+
+func (idx timeIndexComputation[R]) ForValue(value any) indexes.Key {
+	return NewTimeKey(value.(time.Time))
+}
+
+func (idx timeIndexComputation[R]) Check(
+	indexKey indexes.Key,
+	comparator where.FieldComparator[R],
+) (bool, error) {
+	return comparator.(timeComparator).CompareValue(indexKey.(TimeKey).Time())
+}
+
+func CreateTimeIndexComputation[R record.Record](
+	getter record.GetterInterface[R, time.Time],
+) indexes.IndexComputer[R] {
+	return timeIndexComputation[R]{getter: getter}
+}
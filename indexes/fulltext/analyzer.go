@@ -0,0 +1,203 @@
+package fulltext
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/shamcode/simd/fold"
+)
+
+// Token is a term of text with position of word in source text.
+// Positions aren't changed by filters, so phrases match over removed stop words.
+type Token struct {
+	Term     string
+	Position int
+}
+
+// Tokenizer splits text into tokens.
+type Tokenizer interface {
+	Tokenize(text string) []Token
+}
+
+// Filter transforms tokens: changes terms (lowercase, stemming) or removes tokens (stop words).
+type Filter interface {
+	Filter(tokens []Token) []Token
+}
+
+type (
+	TokenizerFunc func(text string) []Token
+	FilterFunc    func(tokens []Token) []Token
+)
+
+func (f TokenizerFunc) Tokenize(text string) []Token { return f(text) }
+func (f FilterFunc) Filter(tokens []Token) []Token   { return f(tokens) }
+
+// Analyzer converts text to terms of index, the same analyzer must be used for indexing and for queries.
+type Analyzer struct {
+	Tokenizer Tokenizer
+	Filters   []Filter
+}
+
+func (a Analyzer) Analyze(text string) []Token {
+	tokens := a.Tokenizer.Tokenize(text)
+	for _, filter := range a.Filters {
+		tokens = filter.Filter(tokens)
+	}
+
+	return tokens
+}
+
+// sameAnalyzer checks that b is a copy of analyzer a: analyzers have the same tokenizer and the same slice
+// of filters. Functions are compared by code, so text is analyzed by both analyzers for detecting tokenizers
+// with different captured data.
+func sameAnalyzer(a, b Analyzer, text string) bool {
+	if len(a.Filters) != len(b.Filters) || len(a.Filters) > 0 && &a.Filters[0] != &b.Filters[0] {
+		return false
+	}
+
+	if !sameTokenizer(a.Tokenizer, b.Tokenizer) {
+		return false
+	}
+
+	return slices.Equal(a.Analyze(text), b.Analyze(text))
+}
+
+func sameTokenizer(a, b Tokenizer) bool {
+	aValue, bValue := reflect.ValueOf(a), reflect.ValueOf(b)
+	if !aValue.IsValid() || !bValue.IsValid() {
+		return aValue.IsValid() == bValue.IsValid()
+	}
+
+	if aValue.Type() != bValue.Type() {
+		return false
+	}
+
+	switch aValue.Kind() { //nolint:exhaustive
+	case reflect.Func, reflect.Pointer, reflect.Map, reflect.Slice, reflect.Chan, reflect.UnsafePointer:
+		return aValue.Pointer() == bValue.Pointer()
+	default:
+		return aValue.Comparable() && aValue.Equal(bValue)
+	}
+}
+
+func NewAnalyzer(tokenizer Tokenizer, filters ...Filter) Analyzer {
+	return Analyzer{
+		Tokenizer: tokenizer,
+		Filters:   filters,
+	}
+}
+
+// StandardAnalyzer splits text into words, converts them to lower case, removes English stop words
+// and strips common English suffixes.
+func StandardAnalyzer() Analyzer {
+	return NewAnalyzer(WordTokenizer, Lowercase, StopWords(EnglishStopWords...), EnglishStemmer)
+}
+
+// WordTokenizer splits text into words: sequences of letters and digits.
+var WordTokenizer = TokenizerFunc(func(text string) []Token {
+	var tokens []Token
+
+	for _, word := range strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		tokens = append(tokens, Token{
+			Term:     word,
+			Position: len(tokens),
+		})
+	}
+
+	return tokens
+})
+
+// Lowercase converts terms to lower case.
+var Lowercase = mapTerms(strings.ToLower)
+
+// Fold converts terms to lower case and removes accents, see fold.String.
+var Fold = mapTerms(fold.String)
+
+func mapTerms(mapping func(term string) string) FilterFunc {
+	return func(tokens []Token) []Token {
+		for i := range tokens {
+			tokens[i].Term = mapping(tokens[i].Term)
+		}
+
+		return tokens
+	}
+}
+
+// EnglishStopWords is a list of frequent English words without meaning for search.
+var EnglishStopWords = []string{ //nolint:gochecknoglobals
+	"a", "an", "and", "are", "as", "at", "be", "but", "by", "for", "if", "in", "into", "is", "it",
+	"no", "not", "of", "on", "or", "such", "that", "the", "their", "then", "there", "these",
+	"they", "this", "to", "was", "will", "with",
+}
+
+// StopWords removes tokens with terms from the list, terms are compared after previous filters.
+func StopWords(words ...string) FilterFunc {
+	stop := make(map[string]struct{}, len(words))
+	for _, word := range words {
+		stop[word] = struct{}{}
+	}
+
+	return func(tokens []Token) []Token {
+		res := tokens[:0]
+
+		for _, token := range tokens {
+			if _, ok := stop[token.Term]; !ok {
+				res = append(res, token)
+			}
+		}
+
+		return res
+	}
+}
+
+// EnglishStemmer strips common English suffixes of plural forms and verb forms: "shoes" -> "shoe",
+// "running" -> "run", "batteries" -> "battery". It's a simple suffix stripping, not a full Porter stemmer.
+var EnglishStemmer = mapTerms(stem)
+
+// stemRules is applied in order, the first rule with matched suffix wins.
+var stemRules = []struct { //nolint:gochecknoglobals
+	suffix      string
+	replacement string
+}{
+	{suffix: "ies", replacement: "y"},
+	{suffix: "sses", replacement: "ss"},
+	{suffix: "xes", replacement: "x"},
+	{suffix: "ches", replacement: "ch"},
+	{suffix: "shes", replacement: "sh"},
+	{suffix: "ss", replacement: "ss"},
+	{suffix: "us", replacement: "us"},
+	{suffix: "s", replacement: ""},
+	{suffix: "ing", replacement: ""},
+	{suffix: "ed", replacement: ""},
+}
+
+// minStemLength is a minimal length of stem, shorter words aren't changed.
+const minStemLength = 3
+
+func stem(term string) string {
+	for _, rule := range stemRules {
+		base, ok := strings.CutSuffix(term, rule.suffix)
+		if !ok {
+			continue
+		}
+
+		if len(base)+len(rule.replacement) < minStemLength {
+			return term
+		}
+
+		// Double consonant after removing -ing and -ed: running -> run, stopped -> stop
+		if rule.replacement == "" && (rule.suffix == "ing" || rule.suffix == "ed") {
+			if n := len(base); n > minStemLength && base[n-1] == base[n-2] && !strings.ContainsRune("lsz", rune(base[n-1])) {
+				base = base[:n-1]
+			}
+		}
+
+		return base + rule.replacement
+	}
+
+	return term
+}
@@ -0,0 +1,96 @@
+package fulltext
+
+import (
+	"testing"
+
+	asserts "github.com/shamcode/assert"
+)
+
+func TestStandardAnalyzer(t *testing.T) {
+	analyzer := StandardAnalyzer()
+
+	testCases := []struct {
+		text     string
+		expected []Token
+	}{
+		{
+			text: "Running shoes for the city",
+			expected: []Token{
+				{Term: "run", Position: 0},
+				{Term: "shoe", Position: 1},
+				{Term: "city", Position: 4},
+			},
+		},
+		{
+			text: "Batteries, boxes & dresses: stopped!",
+			expected: []Token{
+				{Term: "battery", Position: 0},
+				{Term: "box", Position: 1},
+				{Term: "dress", Position: 2},
+				{Term: "stop", Position: 3},
+			},
+		},
+		{
+			text: "bus class is used",
+			expected: []Token{
+				{Term: "bus", Position: 0},
+				{Term: "class", Position: 1},
+				{Term: "used", Position: 3},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.text, func(t *testing.T) {
+			asserts.Equals(t, testCase.expected, analyzer.Analyze(testCase.text), "tokens")
+		})
+	}
+}
+
+func TestQuery(t *testing.T) {
+	analyzer := StandardAnalyzer()
+
+	testCases := []struct {
+		query    string
+		text     string
+		expected bool
+	}{
+		{query: `red shoes`, text: "Shoes, red", expected: true},
+		{query: `red shoes`, text: "Red hat", expected: false},
+		{query: `"red shoes"`, text: "Shoes, red", expected: false},
+		{query: `"red shoes"`, text: "Red running shoes", expected: false},
+		{query: `"red running shoes"`, text: "Red running shoes", expected: true},
+		{query: `"shoes for a city"`, text: "Running shoes for the city", expected: true},
+		{query: `"shoes for city"`, text: "Running shoes for the city", expected: false},
+		{query: `red OR blue shoes`, text: "Blue shoes", expected: true},
+		{query: `red OR blue shoes`, text: "Green shoes", expected: false},
+		{query: `boots OR "running shoes"`, text: "Running shoes for the city", expected: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.query+" ~ "+testCase.text, func(t *testing.T) {
+			q, err := ParseQuery(analyzer, testCase.query)
+			asserts.Success(t, err)
+			asserts.Equals(t, testCase.expected, q.Match(analyzer.Analyze(testCase.text)), "match")
+		})
+	}
+
+	t.Run("errors", func(t *testing.T) {
+		testCases := []struct {
+			query    string
+			expected error
+		}{
+			{query: `the`, expected: ErrEmptyQuery},
+			{query: `"red shoes`, expected: ErrUnclosedPhrase},
+			{query: `OR red`, expected: ErrInvalidOrPosition},
+			{query: `red OR OR blue`, expected: ErrInvalidOrPosition},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.query, func(t *testing.T) {
+				_, err := ParseQuery(analyzer, testCase.query)
+				asserts.Equals(t, testCase.expected, err, "error")
+			})
+		}
+	})
+}
@@ -0,0 +1,67 @@
+package fulltext
+
+import (
+	"github.com/shamcode/simd/query"
+	"github.com/shamcode/simd/record"
+	"github.com/shamcode/simd/where"
+)
+
+// MatchComparator checks that text of field matches full-text query (where.Match).
+type MatchComparator[R record.Record] struct {
+	Getter   record.GetterInterface[R, string]
+	Analyzer Analyzer
+	Query    Query
+}
+
+func (fc MatchComparator[R]) GetType() where.ComparatorType {
+	return where.Match
+}
+
+func (fc MatchComparator[R]) GetField() record.Field {
+	return fc.Getter
+}
+
+func (fc MatchComparator[R]) CompareValue(value string) (bool, error) {
+	return fc.Query.Match(fc.Analyzer.Analyze(value)), nil
+}
+
+func (fc MatchComparator[R]) Compare(item R) (bool, error) {
+	return fc.CompareValue(fc.Getter.GetForRecord(item))
+}
+
+func (fc MatchComparator[R]) ValuesCount() int {
+	return 1
+}
+
+func (fc MatchComparator[R]) ValueAt(index int) any {
+	if index == 0 {
+		return fc.Query.Text
+	}
+
+	return nil
+}
+
+// Match adds condition for full-text search by text field, see Query for syntax of text.
+// Analyzer must be the same analyzer as analyzer of index, otherwise index isn't applied for the condition.
+func Match[R record.Record](
+	getter record.GetterInterface[R, string],
+	analyzer Analyzer,
+	text string,
+) query.WhereOption[R] {
+	q, err := ParseQuery(analyzer, text)
+	if err != nil {
+		return query.WhereOption[R]{
+			Cmp:   nil,
+			Error: query.GetterError{Field: getter, Err: err},
+		}
+	}
+
+	return query.WhereOption[R]{
+		Cmp: MatchComparator[R]{
+			Getter:   getter,
+			Analyzer: analyzer,
+			Query:    q,
+		},
+		Error: nil,
+	}
+}
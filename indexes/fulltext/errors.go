@@ -0,0 +1,11 @@
+package fulltext
+
+import "errors"
+
+var (
+	ErrEmptyQuery         = errors.New("match query has no terms")
+	ErrUnclosedPhrase     = errors.New("phrase isn't closed by quote")
+	ErrNotFulltextIndex   = errors.New("index is not created by fulltext package")
+	ErrInvalidOrPosition  = errors.New("OR must be between terms")
+	ErrInvalidValuesCount = errors.New("match condition requires one text value")
+)
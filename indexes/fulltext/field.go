@@ -0,0 +1,53 @@
+package fulltext
+
+import (
+	"slices"
+
+	"github.com/shamcode/simd/query"
+	"github.com/shamcode/simd/record"
+	"github.com/shamcode/simd/registry"
+	"github.com/shamcode/simd/where"
+)
+
+type field[R record.Record] struct {
+	registry.Field[R]
+
+	getter   record.ComparableGetter[R, string]
+	analyzer Analyzer
+}
+
+func (f field[R]) Where(cmp where.ComparatorType, values ...any) query.WhereOption[R] {
+	if cmp != where.Match {
+		return f.Field.Where(cmp, values...)
+	}
+
+	text, err := registry.ConvertAll[string](values)
+	if err != nil {
+		return query.WhereOption[R]{
+			Cmp:   nil,
+			Error: query.GetterError{Field: f.getter.Field, Err: err},
+		}
+	}
+
+	if len(text) != 1 {
+		return query.WhereOption[R]{
+			Cmp:   nil,
+			Error: query.GetterError{Field: f.getter.Field, Err: ErrInvalidValuesCount},
+		}
+	}
+
+	return Match[R](f.getter, f.analyzer, text[0])
+}
+
+func (f field[R]) Comparators() []where.ComparatorType {
+	return append(slices.Clip(f.Field.Comparators()), where.Match)
+}
+
+// Field creates field of registry with support of where.Match condition, analyzer must be the same as analyzer of index.
+func Field[R record.Record](getter record.ComparableGetter[R, string], analyzer Analyzer) registry.Field[R] {
+	return field[R]{
+		Field:    registry.Comparable(getter),
+		getter:   getter,
+		analyzer: analyzer,
+	}
+}
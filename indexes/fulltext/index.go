@@ -0,0 +1,122 @@
+//nolint:exhaustive,nonamedreturns
+package fulltext
+
+import (
+	"github.com/shamcode/simd/indexes"
+	"github.com/shamcode/simd/indexes/compute"
+	"github.com/shamcode/simd/record"
+	"github.com/shamcode/simd/storage"
+	"github.com/shamcode/simd/where"
+)
+
+type Storage interface {
+	indexes.Storage
+	Keys() []indexes.Key
+	Search(q Query) (int, []storage.IDIterator)
+	Scores(terms []string, params BM25) map[string]float64
+}
+
+type index[R record.Record] struct {
+	getter   record.GetterInterface[R, string]
+	analyzer Analyzer
+	compute  indexes.IndexComputer[R]
+	storage  indexes.ConcurrentStorage
+}
+
+func (idx index[R]) inverted() Storage {
+	return idx.storage.Unwrap().(Storage)
+}
+
+func (idx index[R]) Field() record.Field {
+	return idx.getter
+}
+
+func (idx index[R]) Unique() bool {
+	return false
+}
+
+func (idx index[R]) Compute() indexes.IndexComputer[R] {
+	return idx.compute
+}
+
+func (idx index[R]) Weight(condition where.Condition[R]) (canApplyIndex bool, weight indexes.IndexWeight) {
+	if !indexes.FoldingMatches(idx.compute, condition) {
+		return false, 0
+	}
+
+	if cmp, ok := idx.matchComparator(condition); ok {
+		if !sameAnalyzer(idx.analyzer, cmp.Analyzer, cmp.Query.Text) {
+			// Terms of other analyzer can't be found in the index
+			return false, 0
+		}
+
+		if !condition.WithNot {
+			// Inverted index optimal for full-text search
+			return true, indexes.IndexWeightLow
+		}
+	}
+
+	if isEquality, exclude := indexes.EqualityCondition(condition); isEquality && !exclude {
+		return true, indexes.IndexWeightMedium
+	}
+
+	// For other condition index can apply, but not optimal
+	return true, indexes.IndexWeightHigh
+}
+
+// matchComparator returns comparator of Match condition.
+func (idx index[R]) matchComparator(condition where.Condition[R]) (MatchComparator[R], bool) {
+	cmp, ok := condition.Cmp.(MatchComparator[R])
+
+	return cmp, ok && cmp.GetType() == where.Match
+}
+
+func (idx index[R]) Select(condition where.Condition[R]) (count int, ids []storage.IDIterator, err error) {
+	if cmp, ok := idx.matchComparator(condition); ok && !condition.WithNot {
+		idx.storage.RLock()
+		count, ids = idx.inverted().Search(cmp.Query)
+		idx.storage.RUnlock()
+
+		return
+	}
+
+	if isEquality, exclude := indexes.EqualityCondition(condition); isEquality && !exclude {
+		count, ids = indexes.SelectForValues(idx.storage, idx.compute, condition)
+		return
+	}
+
+	return idx.selectForOther(condition)
+}
+
+// SelectExcluded selects records with values of NE, NOT IN conditions for subtracting from all records.
+func (idx index[R]) SelectExcluded(condition where.Condition[R]) (
+	canApplyIndex bool,
+	count int,
+	ids []storage.IDIterator,
+	err error,
+) {
+	return indexes.SelectExcluded(idx.storage, idx.compute, condition)
+}
+
+func (idx index[R]) selectForOther(condition where.Condition[R]) (count int, ids []storage.IDIterator, err error) {
+	idx.storage.RLock()
+	keys := idx.inverted().Keys()
+	idx.storage.RUnlock()
+
+	return indexes.SelectForKeys(idx.storage, idx.compute, keys, condition)
+}
+
+func (idx index[R]) ConcurrentStorage() indexes.ConcurrentStorage {
+	return idx.storage
+}
+
+// NewIndex creates full-text index of string field, use Match for conditions with the same analyzer
+// and Relevance for sorting by relevance.
+func NewIndex[R record.Record](getter record.GetterInterface[R, string], analyzer Analyzer) indexes.Index[R] {
+	return index[R]{
+		getter:   getter,
+		analyzer: analyzer,
+		compute:  compute.CreateIndexComputation(getter),
+		storage:  indexes.CreateConcurrentStorage(NewInvertedList(analyzer), false),
+	}
+}
@@ -0,0 +1,198 @@
+//nolint:exhaustruct
+package fulltext
+
+import (
+	"testing"
+
+	asserts "github.com/shamcode/assert"
+	"github.com/shamcode/simd/executor"
+	"github.com/shamcode/simd/indexes/hash"
+	"github.com/shamcode/simd/namespace"
+	"github.com/shamcode/simd/ql"
+	"github.com/shamcode/simd/query"
+	"github.com/shamcode/simd/record"
+	"github.com/shamcode/simd/registry"
+	"github.com/shamcode/simd/sort"
+	"github.com/shamcode/simd/where"
+)
+
+type product struct {
+	id    int64
+	title string
+}
+
+func (p *product) GetID() int64 { return p.id }
+
+var fields = record.NewFields()
+
+var id = record.NewIDGetter[*product]()
+
+var title = record.ComparableGetter[*product, string]{
+	Field: fields.New("title"),
+	Get:   func(item *product) string { return item.title },
+}
+
+func TestIndex(t *testing.T) {
+	analyzer := StandardAnalyzer()
+	index := NewIndex(title, analyzer)
+
+	store := namespace.CreateNamespace[*product]()
+	store.AddIndex(index)
+
+	for _, item := range []*product{
+		{id: 1, title: "Red running shoes"},
+		{id: 2, title: "Blue running shoes for the city"},
+		{id: 3, title: "Red hat"},
+		{id: 4, title: "Shoes, shoes, shoes: red shoes sale"},
+		{id: 5, title: "Running shorts"},
+		{id: 6, title: "Red running shoes"},
+	} {
+		asserts.Success(t, store.Insert(item))
+	}
+
+	asserts.Success(t, store.Upsert(&product{id: 6, title: "Green running shoes"}))
+
+	qe := executor.CreateQueryExecutor[*product](store)
+
+	fetch := func(t *testing.T, q query.Query[*product]) []int64 {
+		t.Helper()
+
+		cursor, err := qe.FetchAll(t.Context(), q)
+		asserts.Success(t, err)
+
+		ids := make([]int64, 0, cursor.Size())
+		for item := range cursor.Seq(t.Context()) {
+			ids = append(ids, item.id)
+		}
+
+		asserts.Success(t, cursor.Err())
+
+		return ids
+	}
+
+	t.Run("select", func(t *testing.T) {
+		testCases := []struct {
+			text        string
+			withNot     bool
+			expectedIDs []int64
+		}{
+			{text: `shoes`, expectedIDs: []int64{1, 2, 4, 6}},
+			{text: `red shoe`, expectedIDs: []int64{1, 4}},
+			{text: `"running shoes"`, expectedIDs: []int64{1, 2, 6}},
+			{text: `red OR green "running shoes"`, expectedIDs: []int64{1, 6}},
+			{text: `run`, withNot: true, expectedIDs: []int64{3, 4}},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.text, func(t *testing.T) {
+				condition := where.Condition[*product]{
+					WithNot: testCase.withNot,
+					Cmp:     Match(title, analyzer, testCase.text).Cmp,
+				}
+
+				count, ids, err := index.Select(condition)
+				asserts.Success(t, err)
+				asserts.Equals(t, len(testCase.expectedIDs), count, "count from index")
+				asserts.Equals(t, count > 0, len(ids) > 0, "ids from index")
+
+				builder := query.NewBuilder[*product]()
+				if testCase.withNot {
+					builder.Not()
+				}
+
+				asserts.Equals(
+					t,
+					testCase.expectedIDs,
+					fetch(t, builder.Where(Match(title, analyzer, testCase.text)).Sort(sort.Asc(id)).Query()),
+					"ids",
+				)
+			})
+		}
+	})
+
+	t.Run("analyzer of query", func(t *testing.T) {
+		testCases := []struct {
+			name             string
+			analyzer         Analyzer
+			expectedCanApply bool
+			expectedIDs      []int64
+		}{
+			{
+				name:             "analyzer of index",
+				analyzer:         analyzer,
+				expectedCanApply: true,
+				expectedIDs:      []int64{1, 2, 6},
+			},
+			{
+				name:             "other analyzer",
+				analyzer:         NewAnalyzer(WordTokenizer, Lowercase),
+				expectedCanApply: false,
+				expectedIDs:      []int64{1, 2, 6},
+			},
+			{
+				name:             "other stop words",
+				analyzer:         NewAnalyzer(WordTokenizer, Lowercase, StopWords("running"), EnglishStemmer),
+				expectedCanApply: false,
+				expectedIDs:      []int64{1, 2, 4, 6},
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				option := Match(title, testCase.analyzer, "running shoes")
+				asserts.Success(t, option.Error)
+
+				canApply, _ := index.Weight(where.Condition[*product]{Cmp: option.Cmp})
+				asserts.Equals(t, testCase.expectedCanApply, canApply, "can apply")
+
+				asserts.Equals(
+					t,
+					testCase.expectedIDs,
+					fetch(t, query.NewBuilder[*product]().Where(option).Sort(sort.Asc(id)).Query()),
+					"ids",
+				)
+			})
+		}
+	})
+
+	t.Run("relevance", func(t *testing.T) {
+		relevance, err := Relevance(index, "red shoes")
+		asserts.Success(t, err)
+
+		q := query.NewBuilder[*product]().
+			Where(Match(title, analyzer, "red OR shoes")).
+			Sort(sort.Desc(relevance)).
+			Sort(sort.Asc(id)).
+			Query()
+
+		asserts.Equals(t, []int64{1, 4, 3, 6, 2}, fetch(t, q), "ids")
+
+		// Scores are calculated for each execution by current statistics of index
+		asserts.Success(t, store.Upsert(&product{id: 5, title: "Red shoes"}))
+		t.Cleanup(func() { asserts.Success(t, store.Upsert(&product{id: 5, title: "Running shorts"})) })
+
+		asserts.Equals(t, []int64{5, 1, 4, 3, 6, 2}, fetch(t, q), "ids after upsert")
+
+		withParams, err := RelevanceWithParams(index, "red shoes", BM25{K1: 2, B: 0.5})
+		asserts.Success(t, err)
+		asserts.Equals(t, `relevance(title, "red shoes", k1=1.2, b=0.75)`, relevance.String(), "string")
+		asserts.Equals(t, `relevance(title, "red shoes", k1=2, b=0.5)`, withParams.String(), "string with params")
+	})
+
+	t.Run("registry field", func(t *testing.T) {
+		registered := registry.New[*product]()
+		asserts.Success(t, registered.Add(registry.Comparable(id), Field(title, analyzer)))
+
+		q, err := ql.Parse(registered, `title MATCH "running shoes" AND title != "Red running shoes" ORDER BY ID`)
+		asserts.Success(t, err)
+		asserts.Equals(t, []int64{2, 6}, fetch(t, q), "ids")
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		option := Match(title, analyzer, "the")
+		asserts.Equals(t, "title: match query has no terms", option.Error.Error(), "error of empty query")
+
+		_, err := Relevance(hash.NewComparableHashIndex(title, false), "red")
+		asserts.Equals(t, ErrNotFulltextIndex, err, "error of not full-text index")
+	})
+}
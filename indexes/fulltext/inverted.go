@@ -0,0 +1,172 @@
+package fulltext
+
+import (
+	"github.com/shamcode/simd/indexes"
+	"github.com/shamcode/simd/indexes/compute"
+	"github.com/shamcode/simd/storage"
+)
+
+// document is a distinct text of field, records contains IDs of records with the text.
+type document struct {
+	text    string
+	length  int
+	records storage.IDStorage
+}
+
+type (
+	postings     map[*document][]int
+	invertedList struct {
+		analyzer  Analyzer
+		documents map[string]*document
+		terms     map[string]postings
+	}
+)
+
+func keyValue(key indexes.Key) string {
+	return key.(compute.ComparableKey[string]).Value
+}
+
+func (idx *invertedList) Get(key indexes.Key) storage.IDStorage {
+	if doc, ok := idx.documents[keyValue(key)]; ok {
+		return doc.records
+	}
+
+	return nil
+}
+
+func (idx *invertedList) Set(key indexes.Key, records storage.IDStorage) {
+	text := keyValue(key)
+	if doc, ok := idx.documents[text]; ok {
+		doc.records = records
+		return
+	}
+
+	tokens := idx.analyzer.Analyze(text)
+	doc := &document{
+		text:    text,
+		length:  len(tokens),
+		records: records,
+	}
+	idx.documents[text] = doc
+
+	for _, token := range tokens {
+		byDocument, ok := idx.terms[token.Term]
+		if !ok {
+			byDocument = make(postings)
+			idx.terms[token.Term] = byDocument
+		}
+
+		byDocument[doc] = append(byDocument[doc], token.Position)
+	}
+}
+
+func (idx *invertedList) Keys() []indexes.Key {
+	keys := make([]indexes.Key, 0, len(idx.documents))
+	for text := range idx.documents {
+		keys = append(keys, compute.ComparableKey[string]{Value: text})
+	}
+
+	return keys
+}
+
+// Search selects records with text matched the query.
+func (idx *invertedList) Search(q Query) (count int, ids []storage.IDIterator) { //nolint:nonamedreturns
+	var matched map[*document]struct{}
+
+	for _, group := range q.Groups {
+		documents := idx.searchGroup(group)
+		if nil == matched {
+			matched = documents
+		} else {
+			for doc := range matched {
+				if _, ok := documents[doc]; !ok {
+					delete(matched, doc)
+				}
+			}
+		}
+
+		if len(matched) == 0 {
+			return 0, nil
+		}
+	}
+
+	for doc := range matched {
+		if itemCount := doc.records.Count(); itemCount > 0 {
+			count += itemCount
+
+			ids = append(ids, doc.records)
+		}
+	}
+
+	return count, ids
+}
+
+func (idx *invertedList) searchGroup(group []Phrase) map[*document]struct{} {
+	res := make(map[*document]struct{})
+
+	for _, phrase := range group {
+		for doc := range idx.terms[phrase[0].Term] {
+			if _, ok := res[doc]; ok {
+				continue
+			}
+
+			if matchPhrase(phrase, func(term string) []int { return idx.terms[term][doc] }) {
+				res[doc] = struct{}{}
+			}
+		}
+	}
+
+	return res
+}
+
+// Scores calculates BM25 scores of texts with any of terms, statistics are calculated by count of records.
+func (idx *invertedList) Scores(terms []string, params BM25) map[string]float64 {
+	var (
+		records     int
+		totalLength int
+	)
+
+	for _, doc := range idx.documents {
+		itemCount := doc.records.Count()
+		records += itemCount
+		totalLength += itemCount * doc.length
+	}
+
+	scores := make(map[string]float64)
+	if records == 0 {
+		return scores
+	}
+
+	averageLength := float64(totalLength) / float64(records)
+
+	for _, term := range terms {
+		freq := 0
+
+		for doc := range idx.terms[term] {
+			freq += doc.records.Count()
+		}
+
+		if freq == 0 {
+			continue
+		}
+
+		idf := params.IDF(records, freq)
+
+		for doc, positions := range idx.terms[term] {
+			if doc.records.Count() > 0 {
+				scores[doc.text] += idf * params.TermWeight(len(positions), doc.length, averageLength)
+			}
+		}
+	}
+
+	return scores
+}
+
+// NewInvertedList creates storage of full-text index, which keeps postings of terms for distinct texts.
+func NewInvertedList(analyzer Analyzer) Storage {
+	return &invertedList{
+		analyzer:  analyzer,
+		documents: make(map[string]*document),
+		terms:     make(map[string]postings),
+	}
+}
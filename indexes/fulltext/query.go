@@ -0,0 +1,184 @@
+package fulltext
+
+import (
+	"strings"
+)
+
+// Phrase is a sequence of terms with relative positions, a single term is a phrase of one token.
+type Phrase []Token
+
+// Query is a parsed text of Match condition: all groups must match, group matches if any phrase matches.
+//
+// Syntax of text: words and quoted phrases separated by spaces are combined by AND,
+// OR keyword combines neighbours into group:
+//
+//	red "running shoes" OR sneakers
+//
+// means red AND ("running shoes" OR sneakers). Words and phrases are processed by analyzer,
+// words removed by analyzer (stop words) are ignored.
+type Query struct {
+	Text   string
+	Groups [][]Phrase
+}
+
+// Terms returns unique terms of the query.
+func (q Query) Terms() []string {
+	var (
+		terms []string
+		seen  = make(map[string]struct{})
+	)
+
+	for _, group := range q.Groups {
+		for _, phrase := range group {
+			for _, token := range phrase {
+				if _, ok := seen[token.Term]; !ok {
+					seen[token.Term] = struct{}{}
+					terms = append(terms, token.Term)
+				}
+			}
+		}
+	}
+
+	return terms
+}
+
+// Match checks that analyzed text matches the query.
+func (q Query) Match(tokens []Token) bool {
+	positions := make(map[string][]int, len(tokens))
+	for _, token := range tokens {
+		positions[token.Term] = append(positions[token.Term], token.Position)
+	}
+
+	for _, group := range q.Groups {
+		if !matchGroup(group, func(term string) []int { return positions[term] }) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func matchGroup(group []Phrase, positions func(term string) []int) bool {
+	for _, phrase := range group {
+		if matchPhrase(phrase, positions) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchPhrase checks that all terms of phrase are placed at the same relative positions.
+func matchPhrase(phrase Phrase, positions func(term string) []int) bool {
+	for _, start := range positions(phrase[0].Term) {
+		found := true
+
+		for _, token := range phrase[1:] {
+			if !hasPosition(positions(token.Term), start+token.Position-phrase[0].Position) {
+				found = false
+				break
+			}
+		}
+
+		if found {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hasPosition(positions []int, position int) bool {
+	for _, p := range positions {
+		if p == position {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseQuery parses text of Match condition.
+func ParseQuery(analyzer Analyzer, text string) (Query, error) {
+	words, err := splitQuery(text)
+	if err != nil {
+		return Query{}, err //nolint:exhaustruct
+	}
+
+	var (
+		groups [][]Phrase
+		joinOr bool
+	)
+
+	for i, word := range words {
+		if !word.quoted && word.text == "OR" {
+			if i == 0 || i == len(words)-1 || joinOr {
+				return Query{}, ErrInvalidOrPosition //nolint:exhaustruct
+			}
+
+			joinOr = true
+
+			continue
+		}
+
+		phrase := Phrase(analyzer.Analyze(word.text))
+		if len(phrase) == 0 {
+			// Only stop words
+			joinOr = false
+			continue
+		}
+
+		if joinOr && len(groups) > 0 {
+			groups[len(groups)-1] = append(groups[len(groups)-1], phrase)
+		} else {
+			groups = append(groups, []Phrase{phrase})
+		}
+
+		joinOr = false
+	}
+
+	if len(groups) == 0 {
+		return Query{}, ErrEmptyQuery //nolint:exhaustruct
+	}
+
+	return Query{
+		Text:   text,
+		Groups: groups,
+	}, nil
+}
+
+type queryWord struct {
+	text   string
+	quoted bool
+}
+
+func splitQuery(text string) ([]queryWord, error) {
+	var words []queryWord
+
+	for {
+		text = strings.TrimLeft(text, " \t\r\n")
+		if text == "" {
+			return words, nil
+		}
+
+		if text[0] == '"' {
+			phrase, rest, ok := strings.Cut(text[1:], `"`)
+			if !ok {
+				return nil, ErrUnclosedPhrase
+			}
+
+			words = append(words, queryWord{text: phrase, quoted: true})
+			text = rest
+
+			continue
+		}
+
+		end := strings.IndexAny(text, " \t\r\n\"")
+		if end < 0 {
+			end = len(text)
+		}
+
+		words = append(words, queryWord{text: text[:end], quoted: false})
+		text = text[end:]
+	}
+}
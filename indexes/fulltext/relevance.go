@@ -0,0 +1,104 @@
+package fulltext
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/shamcode/simd/indexes"
+	"github.com/shamcode/simd/record"
+	"github.com/shamcode/simd/sort"
+)
+
+// BM25 contains parameters of Okapi BM25 ranking function.
+type BM25 struct {
+	// K1 controls saturation of term frequency
+	K1 float64
+	// B controls normalization by text length
+	B float64
+}
+
+// DefaultBM25 is commonly used parameters of BM25.
+var DefaultBM25 = BM25{K1: 1.2, B: 0.75} //nolint:gochecknoglobals,mnd
+
+// IDF returns inverse document frequency of term, which is found in freq of records.
+func (p BM25) IDF(records, freq int) float64 {
+	return math.Log(1 + (float64(records)-float64(freq)+0.5)/(float64(freq)+0.5)) //nolint:mnd
+}
+
+// TermWeight returns weight of term with frequency in text of length.
+func (p BM25) TermWeight(freq, length int, averageLength float64) float64 {
+	tf := float64(freq)
+
+	return tf * (p.K1 + 1) / (tf + p.K1*(1-p.B+p.B*float64(length)/averageLength))
+}
+
+type relevance[R record.Record] struct {
+	index  index[R]
+	query  Query
+	params BM25
+}
+
+// Prepare calculates scores by current statistics of index, executor calls it once per query execution.
+func (r relevance[R]) Prepare() sort.By[R] {
+	r.index.storage.RLock()
+	scores := r.index.inverted().Scores(r.query.Terms(), r.params)
+	r.index.storage.RUnlock()
+
+	return scoredRelevance[R]{relevance: r, scores: scores}
+}
+
+// Less compares records by scores calculated for each call, executor compares by prepared sorting.
+func (r relevance[R]) Less(a, b R) bool {
+	return r.Prepare().Less(a, b)
+}
+
+func (r relevance[R]) String() string {
+	return "relevance(" + r.index.getter.String() + ", " + strconv.Quote(r.query.Text) +
+		", k1=" + strconv.FormatFloat(r.params.K1, 'g', -1, 64) +
+		", b=" + strconv.FormatFloat(r.params.B, 'g', -1, 64) + ")"
+}
+
+// scoredRelevance is relevance with scores calculated for a query execution.
+type scoredRelevance[R record.Record] struct {
+	relevance relevance[R]
+	scores    map[string]float64
+}
+
+// Score returns BM25 score of record.
+func (r scoredRelevance[R]) Score(item R) float64 {
+	return r.scores[r.relevance.index.getter.GetForRecord(item)]
+}
+
+func (r scoredRelevance[R]) Less(a, b R) bool {
+	return r.Score(a) < r.Score(b)
+}
+
+func (r scoredRelevance[R]) String() string {
+	return r.relevance.String()
+}
+
+// Relevance creates sorting by BM25 relevance of field text to the query of Match condition,
+// use sort.Desc for the most relevant records first. Records without terms of the query have zero score.
+// Statistics of index are calculated once per query execution, see sort.Preparer.
+func Relevance[R record.Record](idx indexes.Index[R], text string) (sort.By[R], error) {
+	return RelevanceWithParams(idx, text, DefaultBM25)
+}
+
+// RelevanceWithParams creates sorting by BM25 relevance with custom parameters, see Relevance.
+func RelevanceWithParams[R record.Record](idx indexes.Index[R], text string, params BM25) (sort.By[R], error) {
+	fulltextIndex, ok := idx.(index[R])
+	if !ok {
+		return nil, ErrNotFulltextIndex
+	}
+
+	q, err := ParseQuery(fulltextIndex.analyzer, text)
+	if err != nil {
+		return nil, err
+	}
+
+	return relevance[R]{
+		index:  fulltextIndex,
+		query:  q,
+		params: params,
+	}, nil
+}
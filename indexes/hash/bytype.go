@@ -1,6 +1,8 @@
 package hash
 
 import (
+	"time"
+
 	"github.com/shamcode/simd/indexes"
 	"github.com/shamcode/simd/indexes/compute"
 	"github.com/shamcode/simd/record"
@@ -29,3 +31,43 @@ func NewComparableHashIndex[R record.Record, T record.LessComparable](
 		unique,
 	)
 }
+
+// NewNullableHashIndex creates hash index for nullable field, NULL values are stored in a dedicated key.
+func NewNullableHashIndex[R record.Record, T record.LessComparable](
+	getter record.NullableGetter[R, T],
+	unique bool,
+) indexes.Index[R] {
+	return NewIndex(
+		getter.Field,
+		compute.CreateNullableIndexComputation(getter),
+		CreateHashTable(),
+		unique,
+	)
+}
+
+// NewFoldedStringHashIndex creates hash index with case- and accent-folded keys for conditions of query.FieldFolded.
+func NewFoldedStringHashIndex[R record.Record](
+	getter record.GetterInterface[R, string],
+	unique bool,
+) indexes.Index[R] {
+	return NewIndex(
+		getter,
+		compute.CreateFoldedStringIndexComputation(getter),
+		CreateHashTable(),
+		unique,
+	)
+}
+
+// NewTimeHashIndex creates hash index for time.Time field, keys are instants of time, so values
+// in any location are found.
+func NewTimeHashIndex[R record.Record](
+	getter record.GetterInterface[R, time.Time],
+	unique bool,
+) indexes.Index[R] {
+	return NewIndex(
+		getter,
+		compute.CreateTimeIndexComputation(getter),
+		CreateHashTable(),
+		unique,
+	)
+}
@@ -37,33 +37,60 @@ func (idx index[R]) Compute() indexes.IndexComputer[R] {
 }
 
 func (idx index[R]) Weight(condition where.Condition[R]) (canApplyIndex bool, weight indexes.IndexWeight) {
-	cmp := condition.Cmp.GetType()
-	if !condition.WithNot && (where.EQ == cmp || where.InArray == cmp) {
+	if !indexes.FoldingMatches(idx.compute, condition) {
+		return false, 0
+	}
+
+	if isEquality, exclude := indexes.EqualityCondition(condition); isEquality && !exclude {
 		// Hash index optimal for A == 1 and A in (1, 2, 3)
 		return true, indexes.IndexWeightLow
 	}
 
+	if _, exclude, ok := indexes.NullCondition(idx.compute, condition); ok && !exclude {
+		// NULL values stored in dedicated key
+		return true, indexes.IndexWeightLow
+	}
+
 	// For other condition index can apply, but not optimal
 	return true, indexes.IndexWeightHigh
 }
 
 func (idx index[R]) Select(condition where.Condition[R]) (count int, ids []storage.IDIterator, err error) {
-	if !condition.WithNot {
+	if isEquality, exclude := indexes.EqualityCondition(condition); isEquality && !exclude {
 		switch condition.Cmp.GetType() {
-		case where.EQ:
+		case where.EQ, where.NE:
 			count, ids = idx.selectForEqual(condition)
 			return
-		case where.InArray:
-			count, ids = idx.selectForInArray(condition)
+		case where.InArray, where.NotInArray:
+			count, ids = indexes.SelectForValues(idx.storage, idx.compute, condition)
 			return
 		}
 	}
 
+	if key, exclude, ok := indexes.NullCondition(idx.compute, condition); ok && !exclude {
+		count, ids = idx.selectForKey(key)
+		return
+	}
+
 	return idx.selectForOther(condition)
 }
 
+// SelectExcluded selects records with values of NE, NOT IN conditions for subtracting from all records.
+func (idx index[R]) SelectExcluded(condition where.Condition[R]) (
+	canApplyIndex bool,
+	count int,
+	ids []storage.IDIterator,
+	err error,
+) {
+	return indexes.SelectExcluded(idx.storage, idx.compute, condition)
+}
+
 func (idx index[R]) selectForEqual(condition where.Condition[R]) (count int, ids []storage.IDIterator) {
-	itemsByValue := idx.storage.Get(idx.compute.ForValue(condition.Cmp.ValueAt(0)))
+	return idx.selectForKey(idx.compute.ForValue(condition.Cmp.ValueAt(0)))
+}
+
+func (idx index[R]) selectForKey(key indexes.Key) (count int, ids []storage.IDIterator) {
+	itemsByValue := idx.storage.Get(key)
 	if nil != itemsByValue {
 		count = itemsByValue.Count()
 		ids = []storage.IDIterator{itemsByValue}
@@ -72,42 +99,12 @@ func (idx index[R]) selectForEqual(condition where.Condition[R]) (count int, ids
 	return
 }
 
-func (idx index[R]) selectForInArray(condition where.Condition[R]) (count int, ids []storage.IDIterator) {
-	for i := range condition.Cmp.ValuesCount() {
-		itemsByValue := idx.storage.Get(idx.compute.ForValue(condition.Cmp.ValueAt(i)))
-		if nil != itemsByValue {
-			countForValue := itemsByValue.Count()
-			if countForValue > 0 {
-				count += countForValue
-
-				ids = append(ids, itemsByValue)
-			}
-		}
-	}
-
-	return
-}
-
 func (idx index[R]) selectForOther(condition where.Condition[R]) (count int, ids []storage.IDIterator, err error) {
 	idx.storage.RLock()
 	keys := idx.hashTable().Keys()
 	idx.storage.RUnlock()
 
-	for _, key := range keys {
-		resultForValue, errorForValue := idx.compute.Check(key, condition.Cmp)
-		if errorForValue != nil {
-			err = errorForValue
-			return
-		}
-
-		if condition.WithNot != resultForValue {
-			idsForKey := idx.storage.Get(key)
-			count += idsForKey.Count()
-			ids = append(ids, idsForKey)
-		}
-	}
-
-	return
+	return indexes.SelectForKeys(idx.storage, idx.compute, keys, condition)
 }
 
 func (idx index[R]) ConcurrentStorage() indexes.ConcurrentStorage {
@@ -8,6 +8,7 @@ import (
 	asserts "github.com/shamcode/assert"
 	"github.com/shamcode/simd/indexes"
 	"github.com/shamcode/simd/record"
+	"github.com/shamcode/simd/storage"
 	"github.com/shamcode/simd/where"
 	"github.com/shamcode/simd/where/comparators"
 )
@@ -199,4 +200,186 @@ func TestIndex(t *testing.T) {
 			})
 		}
 	})
+
+	t.Run("select excluded", func(t *testing.T) {
+		testCases := []struct {
+			condition        where.Condition[record.Record]
+			expectedCanApply bool
+			expectedCount    int
+			expectedIDs      []int64
+		}{
+			{
+				condition: where.Condition[record.Record]{
+					Cmp: comparators.NewComparableFieldComparator[record.Record, int64](
+						where.NE,
+						record.Getter[record.Record, int64](_id),
+						5,
+					),
+				},
+				expectedCanApply: true,
+				expectedCount:    1,
+				expectedIDs:      []int64{5},
+			},
+			{
+				condition: where.Condition[record.Record]{
+					Cmp: comparators.NewComparableFieldComparator[record.Record, int64](
+						where.NotInArray,
+						record.Getter[record.Record, int64](_id),
+						1, 5, 11,
+					),
+				},
+				expectedCanApply: true,
+				expectedCount:    2,
+				expectedIDs:      []int64{1, 5},
+			},
+			{
+				condition: where.Condition[record.Record]{
+					WithNot: true,
+					Cmp: comparators.NewComparableFieldComparator[record.Record, int64](
+						where.InArray,
+						record.Getter[record.Record, int64](_id),
+						2, 3,
+					),
+				},
+				expectedCanApply: true,
+				expectedCount:    2,
+				expectedIDs:      []int64{2, 3},
+			},
+			{
+				condition: where.Condition[record.Record]{
+					WithNot: true,
+					Cmp: comparators.NewComparableFieldComparator[record.Record, int64](
+						where.NE,
+						record.Getter[record.Record, int64](_id),
+						2,
+					),
+				},
+				expectedCanApply: false,
+			},
+		}
+
+		for _, test := range testCases {
+			t.Run(test.condition.String(), func(t *testing.T) {
+				t.Parallel()
+
+				canApply, count, idsStorage, err := index.(indexes.ExcludeSelector[record.Record]).SelectExcluded(
+					test.condition,
+				)
+				asserts.Success(t, err)
+				asserts.Equals(t, test.expectedCanApply, canApply, "can apply")
+
+				var ids []int64
+
+				for _, store := range idsStorage {
+					store.Iterate(func(id int64) {
+						ids = append(ids, id)
+					})
+				}
+
+				sort.Sort(_int64(ids))
+				asserts.Equals(t, test.expectedIDs, ids, "ids")
+				asserts.Equals(t, test.expectedCount, count, "count")
+			})
+		}
+	})
+}
+
+func TestNullableIndex(t *testing.T) {
+	values := map[int64]int64{2: 10, 3: 20, 5: 10}
+	getter := record.NullableGetter[record.Record, int64]{
+		Field: record.NewFields().New("value"),
+		Get: func(item record.Record) (int64, bool) {
+			value, ok := values[item.GetID()]
+			return value, ok
+		},
+	}
+	index := NewNullableHashIndex(getter, false)
+
+	var id int64
+	for id = 1; id <= 5; id++ {
+		key := index.Compute().ForValue(nil)
+		if value, ok := values[id]; ok {
+			key = index.Compute().ForValue(value)
+		}
+
+		index.ConcurrentStorage().GetOrCreate(key).Add(id)
+	}
+
+	collect := func(idsStorage []storage.IDIterator) []int64 {
+		var ids []int64
+
+		for _, store := range idsStorage {
+			store.Iterate(func(id int64) {
+				ids = append(ids, id)
+			})
+		}
+
+		sort.Sort(_int64(ids))
+
+		return ids
+	}
+
+	t.Run("IS NULL", func(t *testing.T) {
+		count, ids, err := index.Select(where.Condition[record.Record]{
+			Cmp: comparators.NewNullableFieldComparator(where.IsNull, getter),
+		})
+		asserts.Success(t, err)
+		asserts.Equals(t, 2, count, "count")
+		asserts.Equals(t, []int64{1, 4}, collect(ids), "ids")
+	})
+
+	t.Run("= 10", func(t *testing.T) {
+		count, ids, err := index.Select(where.Condition[record.Record]{
+			Cmp: comparators.NewNullableFieldComparator(where.EQ, getter, 10),
+		})
+		asserts.Success(t, err)
+		asserts.Equals(t, 2, count, "count")
+		asserts.Equals(t, []int64{2, 5}, collect(ids), "ids")
+	})
+
+	t.Run("IS NOT NULL excluded", func(t *testing.T) {
+		canApply, count, ids, err := index.(indexes.ExcludeSelector[record.Record]).SelectExcluded(
+			where.Condition[record.Record]{
+				Cmp: comparators.NewNullableFieldComparator(where.IsNotNull, getter),
+			},
+		)
+		asserts.Success(t, err)
+		asserts.Equals(t, true, canApply, "can apply")
+		asserts.Equals(t, 2, count, "count")
+		asserts.Equals(t, []int64{1, 4}, collect(ids), "ids")
+	})
+}
+
+func TestFoldedIndex(t *testing.T) {
+	getter := record.ComparableGetter[record.Record, string]{
+		Field: record.NewFields().New("name"),
+		Get:   func(record.Record) string { return "" },
+	}
+	index := NewFoldedStringHashIndex(getter, false)
+
+	index.ConcurrentStorage().GetOrCreate(index.Compute().ForValue("Foo")).Add(1)
+	index.ConcurrentStorage().GetOrCreate(index.Compute().ForValue("FOO")).Add(2)
+	index.ConcurrentStorage().GetOrCreate(index.Compute().ForValue("Bar")).Add(3)
+
+	t.Run("exact condition", func(t *testing.T) {
+		canApply, _ := index.Weight(where.Condition[record.Record]{
+			Cmp: comparators.NewStringFieldComparator(where.EQ, getter, "foo"),
+		})
+		asserts.Equals(t, false, canApply, "can apply")
+	})
+
+	t.Run("folded condition", func(t *testing.T) {
+		condition := where.Condition[record.Record]{
+			Cmp: comparators.NewFoldedStringFieldComparator(where.EQ, getter, "fOo"),
+		}
+
+		canApply, weight := index.Weight(condition)
+		asserts.Equals(t, true, canApply, "can apply")
+		asserts.Equals(t, indexes.IndexWeightLow, weight, "weight")
+
+		count, ids, err := index.Select(condition)
+		asserts.Success(t, err)
+		asserts.Equals(t, 2, count, "count")
+		asserts.Equals(t, 1, len(ids), "ids")
+	})
 }
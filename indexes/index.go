@@ -1,6 +1,8 @@
 package indexes
 
 import (
+	"iter"
+
 	"github.com/shamcode/simd/record"
 	"github.com/shamcode/simd/storage"
 	"github.com/shamcode/simd/where"
@@ -16,6 +18,26 @@ type IndexComputer[R record.Record] interface {
 	Check(indexKey Key, comparator where.FieldComparator[R]) (bool, error)
 }
 
+// MultiKeyComputer is an optional interface of IndexComputer for multi-valued fields (sets, slices, maps).
+// Record is posted under every key returned by ForRecordKeys instead of single key of ForRecord.
+type MultiKeyComputer[R record.Record] interface {
+	IndexComputer[R]
+	ForRecordKeys(item R) []Key
+
+	// ForCondition returns keys of posting lists for condition. All is true, if matched records are posted
+	// under all keys, otherwise under any key. Ok is false, if condition can't be selected by keys.
+	ForCondition(comparator where.FieldComparator[R]) (keys []Key, all bool, ok bool)
+}
+
+// KeysForRecord returns all keys of record for computer.
+func KeysForRecord[R record.Record](computer IndexComputer[R], item R) []Key {
+	if multi, ok := computer.(MultiKeyComputer[R]); ok {
+		return multi.ForRecordKeys(item)
+	}
+
+	return []Key{computer.ForRecord(item)}
+}
+
 type Index[R record.Record] interface {
 	Field() record.Field
 	Unique() bool
@@ -25,12 +47,86 @@ type Index[R record.Record] interface {
 	ConcurrentStorage() ConcurrentStorage
 }
 
+// ExcludeSelector is an optional interface of Index for conditions, which match all records except
+// records with set of values (NE, NOT IN, NOT EQ). Namespace subtracts selected IDs from IDs of all records
+// instead of scanning all keys of the index.
+type ExcludeSelector[R record.Record] interface {
+	SelectExcluded(condition where.Condition[R]) (canApplyIndex bool, count int, ids []storage.IDIterator, err error)
+}
+
+// EqualityCondition checks that condition compares field with set of values (EQ, InArray, NE, NotInArray).
+// Exclude is true, if condition matches all records except records with these values: NE, NotInArray
+// and EQ, InArray with NOT.
+func EqualityCondition[R record.Record](condition where.Condition[R]) (isEquality bool, exclude bool) {
+	switch condition.Cmp.GetType() { //nolint:exhaustive
+	case where.EQ, where.InArray:
+		return true, condition.WithNot
+	case where.NE, where.NotInArray:
+		return true, !condition.WithNot
+	default:
+		return false, false
+	}
+}
+
+// ValueKeys iterates distinct keys of values of the comparator. Values with the same key, for example repeated
+// values of NOT IN (1, 1), are yielded once, so records of the key are counted once.
+func ValueKeys[R record.Record](computer IndexComputer[R], cmp where.FieldComparator[R]) iter.Seq[Key] {
+	return func(yield func(Key) bool) {
+		seen := make(map[Key]struct{}, cmp.ValuesCount())
+
+		for value := range where.ValuesOf(cmp) {
+			key := computer.ForValue(value)
+			if _, ok := seen[key]; ok {
+				continue
+			}
+
+			seen[key] = struct{}{}
+
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+// NullableComputer is implemented by computers of nullable fields, which keep NULL values in a dedicated key.
+type NullableComputer interface {
+	ForNull() Key
+}
+
+// NullCondition checks that condition is IsNull or IsNotNull and computer has a dedicated key for NULL.
+// Exclude is true, if condition matches all records except NULL: IsNotNull and IsNull with NOT.
+func NullCondition[R record.Record](
+	computer IndexComputer[R],
+	condition where.Condition[R],
+) (Key, bool, bool) {
+	nullable, isNullable := computer.(NullableComputer)
+	if !isNullable {
+		return nil, false, false
+	}
+
+	switch condition.Cmp.GetType() { //nolint:exhaustive
+	case where.IsNull:
+		return nullable.ForNull(), condition.WithNot, true
+	case where.IsNotNull:
+		return nullable.ForNull(), !condition.WithNot, true
+	default:
+		return nil, false, false
+	}
+}
+
 // Storage is base interface for indexes.
 type Storage interface {
 	Get(key Key) storage.IDStorage
 	Set(key Key, records storage.IDStorage)
 }
 
+// KeysRemover is an optional interface of Storage, which removes keys without records,
+// so the storage doesn't keep keys of deleted and changed values.
+type KeysRemover interface {
+	Remove(key Key)
+}
+
 // ConcurrentStorage wrapped Storage for concurrent safe access.
 type ConcurrentStorage interface {
 	RLock()
@@ -38,4 +134,20 @@ type ConcurrentStorage interface {
 	Unwrap() Storage
 	Get(key Key) storage.IDStorage
 	GetOrCreate(key Key) storage.IDStorage
+
+	// Delete deletes ID from records of key, the key without records is removed, if Storage is KeysRemover.
+	Delete(key Key, id int64)
+}
+
+// FoldingComputer is implemented by computers of string fields, which keep case- and accent-folded keys.
+type FoldingComputer interface {
+	IsFolding() bool
+}
+
+// FoldingMatches checks that index keys and condition values are both folded or both not folded.
+// Keys of folded index can't be used for exact comparing and vice versa.
+func FoldingMatches[R record.Record](computer IndexComputer[R], condition where.Condition[R]) bool {
+	folding, ok := computer.(FoldingComputer)
+
+	return (ok && folding.IsFolding()) == where.IsFolding(condition.Cmp)
 }
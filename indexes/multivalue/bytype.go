@@ -0,0 +1,50 @@
+package multivalue
+
+import (
+	"github.com/shamcode/simd/indexes"
+	"github.com/shamcode/simd/indexes/compute"
+	"github.com/shamcode/simd/indexes/hash"
+	"github.com/shamcode/simd/record"
+)
+
+// NewSetIndex creates index for SetHas, SetHasAny and SetHasAll conditions by set field.
+func NewSetIndex[R record.Record, T record.LessComparable](getter record.SetGetter[R, T]) indexes.Index[R] {
+	return NewIndex(
+		getter.Field,
+		compute.CreateSetIndexComputation(getter),
+		hash.CreateHashTable(),
+	)
+}
+
+// NewSliceIndex creates index for SliceContains and SliceContainsAll conditions by slice field.
+func NewSliceIndex[R record.Record, T record.LessComparable](getter record.SliceGetter[R, T]) indexes.Index[R] {
+	return NewIndex(
+		getter.Field,
+		compute.CreateSliceIndexComputation(getter),
+		hash.CreateHashTable(),
+	)
+}
+
+// NewMapKeysIndex creates index for MapHasKey and MapKeyValueEQ conditions by map field, records are posted
+// under every key of map. Values of the field must implement record.MapEntries.
+func NewMapKeysIndex[R record.Record, K record.LessComparable, V any](
+	getter record.MapGetter[R, K, V],
+) indexes.Index[R] {
+	return NewIndex(
+		getter.Field,
+		compute.CreateMapKeysIndexComputation(getter),
+		hash.CreateHashTable(),
+	)
+}
+
+// NewMapIndex creates index for MapHasKey and MapKeyValueEQ conditions by map field, records are posted
+// under every key and every (key, value) pair of map. Values of the field must implement record.MapEntries.
+func NewMapIndex[R record.Record, K record.LessComparable, V record.LessComparable](
+	getter record.MapGetter[R, K, V],
+) indexes.Index[R] {
+	return NewIndex(
+		getter.Field,
+		compute.CreateMapIndexComputation(getter),
+		hash.CreateHashTable(),
+	)
+}
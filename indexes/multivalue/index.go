@@ -0,0 +1,106 @@
+//nolint:exhaustive,nonamedreturns
+package multivalue
+
+import (
+	"github.com/shamcode/simd/indexes"
+	"github.com/shamcode/simd/record"
+	"github.com/shamcode/simd/storage"
+	"github.com/shamcode/simd/where"
+)
+
+// index posts record under every element of multi-valued field. Records without elements aren't posted,
+// so index can't be applied to conditions with NOT.
+type index[R record.Record] struct {
+	field   record.Field
+	compute indexes.MultiKeyComputer[R]
+	storage indexes.ConcurrentStorage
+}
+
+func (idx index[R]) Field() record.Field {
+	return idx.field
+}
+
+func (idx index[R]) Unique() bool {
+	return false
+}
+
+func (idx index[R]) Compute() indexes.IndexComputer[R] {
+	return idx.compute
+}
+
+func (idx index[R]) Weight(condition where.Condition[R]) (canApplyIndex bool, weight indexes.IndexWeight) {
+	if condition.WithNot {
+		return false, 0
+	}
+
+	if _, _, ok := idx.compute.ForCondition(condition.Cmp); ok {
+		// Posting lists of elements
+		return true, indexes.IndexWeightLow
+	}
+
+	return false, 0
+}
+
+func (idx index[R]) Select(condition where.Condition[R]) (count int, ids []storage.IDIterator, err error) {
+	keys, all, _ := idx.compute.ForCondition(condition.Cmp)
+	if all {
+		count, ids = idx.selectForAll(keys)
+		return
+	}
+
+	count, ids = idx.selectForAny(keys)
+
+	return
+}
+
+// selectForAny selects union of posting lists, IDs of records with several elements are repeated.
+func (idx index[R]) selectForAny(keys []indexes.Key) (count int, ids []storage.IDIterator) {
+	for _, key := range keys {
+		itemsByValue := idx.storage.Get(key)
+		if nil != itemsByValue {
+			countForValue := itemsByValue.Count()
+			if countForValue > 0 {
+				count += countForValue
+
+				ids = append(ids, itemsByValue)
+			}
+		}
+	}
+
+	return
+}
+
+// selectForAll selects the shortest posting list, records without other elements are filtered by condition.
+func (idx index[R]) selectForAll(keys []indexes.Key) (count int, ids []storage.IDIterator) {
+	var shortest storage.IDStorage
+
+	for _, key := range keys {
+		itemsByValue := idx.storage.Get(key)
+		if nil == itemsByValue || itemsByValue.Count() == 0 {
+			return 0, nil
+		}
+
+		if nil == shortest || itemsByValue.Count() < shortest.Count() {
+			shortest = itemsByValue
+		}
+	}
+
+	return shortest.Count(), []storage.IDIterator{shortest}
+}
+
+func (idx index[R]) ConcurrentStorage() indexes.ConcurrentStorage {
+	return idx.storage
+}
+
+// NewIndex creates index of multi-valued field.
+func NewIndex[R record.Record](
+	field record.Field,
+	compute indexes.MultiKeyComputer[R],
+	table indexes.Storage,
+) indexes.Index[R] {
+	return index[R]{
+		field:   field,
+		compute: compute,
+		storage: indexes.CreateConcurrentStorage(table, false),
+	}
+}
@@ -0,0 +1,238 @@
+//nolint:exhaustruct
+package multivalue
+
+import (
+	"sort"
+	"testing"
+
+	asserts "github.com/shamcode/assert"
+	"github.com/shamcode/simd/indexes"
+	"github.com/shamcode/simd/record"
+	"github.com/shamcode/simd/where"
+	"github.com/shamcode/simd/where/comparators"
+)
+
+type _int64 []int64
+
+func (s _int64) Len() int           { return len(s) }
+func (s _int64) Less(i, j int) bool { return s[i] < s[j] }
+func (s _int64) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+type item struct {
+	id    int64
+	tags  record.MapSet[string]
+	attrs record.MapOf[string, int]
+}
+
+func (i *item) GetID() int64 { return i.id }
+
+var tags = record.SetGetter[*item, string]{
+	Field: record.NewFields().New("tags"),
+	Get:   func(item *item) record.Set[string] { return item.tags },
+}
+
+var attrs = record.MapGetter[*item, string, int]{
+	Field: record.NewFields().New("attrs"),
+	Get:   func(item *item) record.Map[string, int] { return item.attrs },
+}
+
+func TestIndex(t *testing.T) {
+	index := NewSetIndex(tags)
+
+	byField := indexes.CreateByField[*item]()
+	byField.Add(index)
+
+	byField.Insert(&item{id: 1, tags: record.NewMapSet("go", "db")})
+	byField.Insert(&item{id: 2, tags: record.NewMapSet("go")})
+	byField.Insert(&item{id: 3, tags: record.NewMapSet("db", "ml")})
+	byField.Insert(&item{id: 4, tags: nil})
+	byField.Insert(&item{id: 5, tags: record.NewMapSet("ml")})
+
+	byField.Update(&item{id: 5, tags: record.NewMapSet("ml")}, &item{id: 5, tags: record.NewMapSet("ml", "go")})
+	byField.Delete(&item{id: 2, tags: record.NewMapSet("go")})
+
+	testCases := []struct {
+		name             string
+		condition        where.Condition[*item]
+		expectedCanApply bool
+		expectedIDs      []int64
+	}{
+		{
+			name: "SetHas go",
+			condition: where.Condition[*item]{
+				Cmp: comparators.NewSetFieldComparator(where.SetHas, tags, "go"),
+			},
+			expectedCanApply: true,
+			expectedIDs:      []int64{1, 5},
+		},
+		{
+			name: "SetHasAny db, ml",
+			condition: where.Condition[*item]{
+				Cmp: comparators.NewSetFieldComparator(where.SetHasAny, tags, "db", "ml"),
+			},
+			expectedCanApply: true,
+			// Record 3 is posted under both elements
+			expectedIDs: []int64{1, 3, 3, 5},
+		},
+		{
+			name: "SetHasAll go, db",
+			condition: where.Condition[*item]{
+				Cmp: comparators.NewSetFieldComparator(where.SetHasAll, tags, "db", "go"),
+			},
+			expectedCanApply: true,
+			// The shortest posting list, records are filtered by condition later
+			expectedIDs: []int64{1, 3},
+		},
+		{
+			name: "SetHasAll go, rust",
+			condition: where.Condition[*item]{
+				Cmp: comparators.NewSetFieldComparator(where.SetHasAll, tags, "go", "rust"),
+			},
+			expectedCanApply: true,
+			expectedIDs:      nil,
+		},
+		{
+			name: "NOT SetHas go",
+			condition: where.Condition[*item]{
+				WithNot: true,
+				Cmp:     comparators.NewSetFieldComparator(where.SetHas, tags, "go"),
+			},
+			expectedCanApply: false,
+		},
+		{
+			name: "SetHasAll",
+			condition: where.Condition[*item]{
+				Cmp: comparators.NewSetFieldComparator[*item, string](where.SetHasAll, tags),
+			},
+			expectedCanApply: false,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			canApply, weight := index.Weight(test.condition)
+			asserts.Equals(t, test.expectedCanApply, canApply, "can apply")
+
+			if !canApply {
+				return
+			}
+
+			asserts.Equals(t, indexes.IndexWeightLow, weight, "weight")
+
+			count, idsStorage, err := index.Select(test.condition)
+			asserts.Success(t, err)
+
+			var ids []int64
+
+			for _, store := range idsStorage {
+				store.Iterate(func(id int64) {
+					ids = append(ids, id)
+				})
+			}
+
+			sort.Sort(_int64(ids))
+			asserts.Equals(t, test.expectedIDs, ids, "ids")
+			asserts.Equals(t, len(test.expectedIDs), count, "count")
+		})
+	}
+}
+
+func TestMapIndex(t *testing.T) {
+	keysIndex := NewMapKeysIndex(attrs)
+	pairsIndex := NewMapIndex(attrs)
+
+	for _, index := range []indexes.Index[*item]{keysIndex, pairsIndex} {
+		byField := indexes.CreateByField[*item]()
+		byField.Add(index)
+
+		byField.Insert(&item{id: 1, attrs: record.MapOf[string, int]{"size": 1, "color": 2}})
+		byField.Insert(&item{id: 2, attrs: record.MapOf[string, int]{"size": 2}})
+		byField.Insert(&item{id: 3, attrs: nil})
+		byField.Insert(&item{id: 4, attrs: record.MapOf[string, int]{"color": 1}})
+
+		byField.Update(
+			&item{id: 4, attrs: record.MapOf[string, int]{"color": 1}},
+			&item{id: 4, attrs: record.MapOf[string, int]{"color": 1, "size": 1}},
+		)
+	}
+
+	testCases := []struct {
+		name        string
+		index       indexes.Index[*item]
+		condition   where.Condition[*item]
+		expectedIDs []int64
+	}{
+		{
+			name:  "keys: MapHasKey size",
+			index: keysIndex,
+			condition: where.Condition[*item]{
+				Cmp: comparators.NewMapFieldComparator(where.MapHasKey, attrs, "size"),
+			},
+			expectedIDs: []int64{1, 2, 4},
+		},
+		{
+			name:  "keys: MapKeyValueEQ size 1",
+			index: keysIndex,
+			condition: where.Condition[*item]{
+				Cmp: comparators.NewMapFieldComparator(where.MapKeyValueEQ, attrs, "size", 1),
+			},
+			// Records with the key, value is checked by condition later
+			expectedIDs: []int64{1, 2, 4},
+		},
+		{
+			name:  "pairs: MapHasKey color",
+			index: pairsIndex,
+			condition: where.Condition[*item]{
+				Cmp: comparators.NewMapFieldComparator(where.MapHasKey, attrs, "color"),
+			},
+			expectedIDs: []int64{1, 4},
+		},
+		{
+			name:  "pairs: MapKeyValueEQ size 1",
+			index: pairsIndex,
+			condition: where.Condition[*item]{
+				Cmp: comparators.NewMapFieldComparator(where.MapKeyValueEQ, attrs, "size", 1),
+			},
+			expectedIDs: []int64{1, 4},
+		},
+		{
+			name:  "pairs: MapKeyValueEQ size 3",
+			index: pairsIndex,
+			condition: where.Condition[*item]{
+				Cmp: comparators.NewMapFieldComparator(where.MapKeyValueEQ, attrs, "size", 3),
+			},
+			expectedIDs: nil,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			canApply, weight := test.index.Weight(test.condition)
+			asserts.Equals(t, true, canApply, "can apply")
+			asserts.Equals(t, indexes.IndexWeightLow, weight, "weight")
+
+			count, idsStorage, err := test.index.Select(test.condition)
+			asserts.Success(t, err)
+
+			var ids []int64
+
+			for _, store := range idsStorage {
+				store.Iterate(func(id int64) {
+					ids = append(ids, id)
+				})
+			}
+
+			sort.Sort(_int64(ids))
+			asserts.Equals(t, test.expectedIDs, ids, "ids")
+			asserts.Equals(t, len(test.expectedIDs), count, "count")
+		})
+	}
+
+	t.Run("NOT MapHasKey", func(t *testing.T) {
+		canApply, _ := pairsIndex.Weight(where.Condition[*item]{
+			WithNot: true,
+			Cmp:     comparators.NewMapFieldComparator(where.MapHasKey, attrs, "size"),
+		})
+		asserts.Equals(t, false, canApply, "can apply")
+	})
+}
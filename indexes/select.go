@@ -0,0 +1,94 @@
+//nolint:nonamedreturns
+package indexes
+
+import (
+	"github.com/shamcode/simd/record"
+	"github.com/shamcode/simd/storage"
+	"github.com/shamcode/simd/where"
+)
+
+// SelectForValues selects records by keys of values of EQ, InArray conditions.
+// Values with the same key are selected once, see ValueKeys.
+func SelectForValues[R record.Record](
+	store ConcurrentStorage,
+	computer IndexComputer[R],
+	condition where.Condition[R],
+) (count int, ids []storage.IDIterator) {
+	for key := range ValueKeys(computer, condition.Cmp) {
+		itemsByValue := store.Get(key)
+		if nil != itemsByValue {
+			countForValue := itemsByValue.Count()
+			if countForValue > 0 {
+				count += countForValue
+
+				ids = append(ids, itemsByValue)
+			}
+		}
+	}
+
+	return
+}
+
+// SelectForKeys checks condition for each key and selects records of matched keys.
+func SelectForKeys[R record.Record](
+	store ConcurrentStorage,
+	computer IndexComputer[R],
+	keys []Key,
+	condition where.Condition[R],
+) (count int, ids []storage.IDIterator, err error) {
+	for _, key := range keys {
+		resultForValue, errorForValue := computer.Check(key, condition.Cmp)
+		if errorForValue != nil {
+			err = errorForValue
+			return
+		}
+
+		if condition.WithNot != resultForValue {
+			// Key can be removed after the keys have been selected
+			idsForKey := store.Get(key)
+			if nil == idsForKey {
+				continue
+			}
+
+			if itemCount := idsForKey.Count(); itemCount > 0 {
+				count += itemCount
+				ids = append(ids, idsForKey)
+			}
+		}
+	}
+
+	return
+}
+
+// SelectExcluded implements ExcludeSelector for indexes with posting list for each key: records with values
+// of NE, NOT IN conditions and NULL of IsNotNull conditions are selected for subtracting from all records.
+func SelectExcluded[R record.Record](
+	store ConcurrentStorage,
+	computer IndexComputer[R],
+	condition where.Condition[R],
+) (canApplyIndex bool, count int, ids []storage.IDIterator, err error) {
+	if !FoldingMatches(computer, condition) {
+		return false, 0, nil, nil
+	}
+
+	if key, exclude, ok := NullCondition(computer, condition); ok {
+		if !exclude {
+			return false, 0, nil, nil
+		}
+
+		if itemsByValue := store.Get(key); nil != itemsByValue {
+			count = itemsByValue.Count()
+			ids = []storage.IDIterator{itemsByValue}
+		}
+
+		return true, count, ids, nil
+	}
+
+	if isEquality, exclude := EqualityCondition(condition); !isEquality || !exclude {
+		return false, 0, nil, nil
+	}
+
+	count, ids = SelectForValues(store, computer, condition)
+
+	return true, count, ids, nil
+}
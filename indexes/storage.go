@@ -47,6 +47,29 @@ func (idx *concurrentStorage) GetOrCreate(key Key) storage.IDStorage {
 	return idStorage
 }
 
+func (idx *concurrentStorage) Delete(key Key, id int64) {
+	idStorage := idx.Get(key)
+	if nil == idStorage {
+		return
+	}
+
+	idStorage.Delete(id)
+
+	remover, ok := idx.original.(KeysRemover)
+	if !ok || idStorage.Count() > 0 {
+		return
+	}
+
+	idx.Lock()
+
+	// Prevent removing of records added in race
+	if idx.original.Get(key) == idStorage && idStorage.Count() == 0 {
+		remover.Remove(key)
+	}
+
+	idx.Unlock()
+}
+
 func (idx *concurrentStorage) Unwrap() Storage {
 	return idx.original
 }
@@ -0,0 +1,129 @@
+package trie
+
+import (
+	"container/heap"
+	"errors"
+	"slices"
+
+	"github.com/shamcode/simd/indexes"
+	"github.com/shamcode/simd/record"
+	"github.com/shamcode/simd/storage"
+)
+
+var ErrNotTrieIndex = errors.New("index is not created by trie package")
+
+// Records is a source of records for ranking of completions, e.g. namespace.Namespace.
+type Records[R record.Record] interface {
+	Get(id int64) (R, bool)
+}
+
+// Completion is a value of field started with prefix.
+type Completion[S record.LessComparable] struct {
+	Value string
+	// Score is the best score of records with the value
+	Score S
+	// Count is a count of records with the value
+	Count int
+}
+
+// better checks that completion must be ranked higher: by score, count of records and value.
+func (c Completion[S]) better(than Completion[S]) bool {
+	switch {
+	case c.Score != than.Score:
+		return c.Score > than.Score
+	case c.Count != than.Count:
+		return c.Count > than.Count
+	default:
+		return c.Value < than.Value
+	}
+}
+
+// completions is a min-heap of the best completions, the worst completion is on top.
+type completions[S record.LessComparable] []Completion[S]
+
+func (h completions[S]) Len() int           { return len(h) }
+func (h completions[S]) Less(i, j int) bool { return h[j].better(h[i]) }
+func (h completions[S]) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *completions[S]) Push(x any)        { *h = append(*h, x.(Completion[S])) }
+func (h *completions[S]) Pop() any {
+	old := *h
+	last := old[len(old)-1]
+	*h = old[:len(old)-1]
+
+	return last
+}
+
+// Autocomplete returns top-k values of field started with prefix, ranked by the best score of records with value.
+// Prefix is converted by computer of index, so folded index completes case- and accent-insensitive
+// and returns folded values.
+func Autocomplete[R record.Record, S record.LessComparable](
+	idx indexes.Index[R],
+	records Records[R],
+	score record.GetterInterface[R, S],
+	prefix string,
+	limit int,
+) ([]Completion[S], error) {
+	tree, ok := idx.(index[R])
+	if !ok {
+		return nil, ErrNotTrieIndex
+	}
+
+	if limit <= 0 {
+		return nil, nil
+	}
+
+	best := make(completions[S], 0, limit)
+
+	tree.storage.RLock()
+	tree.trie().WalkPrefix(keyValue(tree.compute.ForValue(prefix)), func(key string, ids storage.IDStorage) {
+		completion, ok := rank(key, ids, records, score)
+		switch {
+		case !ok:
+			return
+		case best.Len() < limit:
+			heap.Push(&best, completion)
+		case completion.better(best[0]):
+			best[0] = completion
+			heap.Fix(&best, 0)
+		}
+	})
+	tree.storage.RUnlock()
+
+	slices.SortFunc(best, func(a, b Completion[S]) int {
+		if a.better(b) {
+			return -1
+		}
+
+		return 1
+	})
+
+	return best, nil
+}
+
+func rank[R record.Record, S record.LessComparable](
+	key string,
+	ids storage.IDStorage,
+	records Records[R],
+	score record.GetterInterface[R, S],
+) (Completion[S], bool) {
+	var (
+		completion = Completion[S]{Value: key} //nolint:exhaustruct
+		found      bool
+	)
+
+	ids.Iterate(func(id int64) {
+		item, ok := records.Get(id)
+		if !ok {
+			return
+		}
+
+		if value := score.GetForRecord(item); !found || value > completion.Score {
+			completion.Score = value
+		}
+
+		found = true
+		completion.Count++
+	})
+
+	return completion, found
+}
@@ -0,0 +1,34 @@
+package trie
+
+import (
+	"github.com/shamcode/simd/indexes"
+	"github.com/shamcode/simd/indexes/compute"
+	"github.com/shamcode/simd/record"
+)
+
+// NewStringTrieIndex creates radix tree index for prefix search (where.StartsWith) by string field.
+func NewStringTrieIndex[R record.Record](
+	getter record.GetterInterface[R, string],
+	unique bool,
+) indexes.Index[R] {
+	return NewIndex(
+		getter,
+		compute.CreateIndexComputation(getter),
+		NewTree(),
+		unique,
+	)
+}
+
+// NewFoldedStringTrieIndex creates radix tree index with case- and accent-folded keys for conditions
+// of query.FieldFolded.
+func NewFoldedStringTrieIndex[R record.Record](
+	getter record.GetterInterface[R, string],
+	unique bool,
+) indexes.Index[R] {
+	return NewIndex(
+		getter,
+		compute.CreateFoldedStringIndexComputation(getter),
+		NewTree(),
+		unique,
+	)
+}
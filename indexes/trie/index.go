@@ -0,0 +1,193 @@
+//nolint:exhaustive,nonamedreturns
+package trie
+
+import (
+	"regexp"
+	"regexp/syntax"
+	"strings"
+
+	"github.com/shamcode/simd/indexes"
+	"github.com/shamcode/simd/record"
+	"github.com/shamcode/simd/storage"
+	"github.com/shamcode/simd/where"
+)
+
+type Storage interface {
+	indexes.Storage
+	Prefix(prefix string) (int, []storage.IDIterator)
+	WalkPrefix(prefix string, callback func(key string, records storage.IDStorage))
+}
+
+type index[R record.Record] struct {
+	field   record.Field
+	unique  bool
+	compute indexes.IndexComputer[R]
+	storage indexes.ConcurrentStorage
+}
+
+func (idx index[R]) trie() Storage {
+	return idx.storage.Unwrap().(Storage)
+}
+
+func (idx index[R]) Field() record.Field {
+	return idx.field
+}
+
+func (idx index[R]) Unique() bool {
+	return idx.unique
+}
+
+func (idx index[R]) Compute() indexes.IndexComputer[R] {
+	return idx.compute
+}
+
+func (idx index[R]) Weight(condition where.Condition[R]) (canApplyIndex bool, weight indexes.IndexWeight) {
+	if !indexes.FoldingMatches(idx.compute, condition) {
+		return false, 0
+	}
+
+	if _, ok := idx.prefix(condition); ok {
+		// Radix tree optimal for prefix search
+		return true, indexes.IndexWeightLow
+	}
+
+	if isEquality, exclude := indexes.EqualityCondition(condition); isEquality && !exclude {
+		// Hash index more optimal for A == 1 and A in (1, 2, 3)
+		return true, indexes.IndexWeightMedium
+	}
+
+	// For other condition index can apply, but not optimal
+	return true, indexes.IndexWeightHigh
+}
+
+// prefix returns prefix of keys for StartsWith and Regexp with literal prefix (^abc.*) conditions.
+func (idx index[R]) prefix(condition where.Condition[R]) (string, bool) {
+	if condition.WithNot {
+		return "", false
+	}
+
+	switch condition.Cmp.GetType() {
+	case where.StartsWith:
+		return keyValue(idx.compute.ForValue(condition.Cmp.ValueAt(0))), true
+	case where.Regexp:
+		if re, ok := condition.Cmp.ValueAt(0).(*regexp.Regexp); ok {
+			return RegexpPrefix(re)
+		}
+	}
+
+	return "", false
+}
+
+func (idx index[R]) Select(condition where.Condition[R]) (count int, ids []storage.IDIterator, err error) {
+	prefix, isPrefix := idx.prefix(condition)
+
+	switch {
+	case isPrefix && condition.Cmp.GetType() == where.StartsWith:
+		idx.storage.RLock()
+		count, ids = idx.trie().Prefix(prefix)
+		idx.storage.RUnlock()
+
+		return
+	case isPrefix:
+		// Regexp is checked only for keys with literal prefix
+		return idx.selectForPrefix(prefix, condition)
+	}
+
+	if isEquality, exclude := indexes.EqualityCondition(condition); isEquality && !exclude {
+		count, ids = indexes.SelectForValues(idx.storage, idx.compute, condition)
+		return
+	}
+
+	return idx.selectForPrefix("", condition)
+}
+
+// SelectExcluded selects records with values of NE, NOT IN conditions for subtracting from all records.
+func (idx index[R]) SelectExcluded(condition where.Condition[R]) (
+	canApplyIndex bool,
+	count int,
+	ids []storage.IDIterator,
+	err error,
+) {
+	return indexes.SelectExcluded(idx.storage, idx.compute, condition)
+}
+
+// selectForPrefix checks condition for all keys started with prefix.
+func (idx index[R]) selectForPrefix(prefix string, condition where.Condition[R]) (
+	count int,
+	ids []storage.IDIterator,
+	err error,
+) {
+	idx.storage.RLock()
+	defer idx.storage.RUnlock()
+
+	idx.trie().WalkPrefix(prefix, func(key string, records storage.IDStorage) {
+		if err != nil {
+			return
+		}
+
+		resultForValue, errorForValue := idx.compute.Check(idx.compute.ForValue(key), condition.Cmp)
+		if errorForValue != nil {
+			err = errorForValue
+			return
+		}
+
+		if condition.WithNot != resultForValue {
+			if itemCount := records.Count(); itemCount > 0 {
+				count += itemCount
+
+				ids = append(ids, records)
+			}
+		}
+	})
+
+	return
+}
+
+func (idx index[R]) ConcurrentStorage() indexes.ConcurrentStorage {
+	return idx.storage
+}
+
+// RegexpPrefix returns literal prefix of regular expression anchored at beginning of text, e.g. "abc" for ^abc.*
+func RegexpPrefix(re *regexp.Regexp) (string, bool) {
+	parsed, err := syntax.Parse(re.String(), syntax.Perl)
+	if err != nil {
+		return "", false
+	}
+
+	parsed = parsed.Simplify()
+
+	parts := []*syntax.Regexp{parsed}
+	if parsed.Op == syntax.OpConcat {
+		parts = parsed.Sub
+	}
+
+	if len(parts) == 0 || parts[0].Op != syntax.OpBeginText {
+		return "", false
+	}
+
+	var prefix strings.Builder
+
+	for _, part := range parts[1:] {
+		if part.Op != syntax.OpLiteral || part.Flags&syntax.FoldCase != 0 {
+			break
+		}
+
+		prefix.WriteString(string(part.Rune))
+	}
+
+	return prefix.String(), prefix.Len() > 0
+}
+
+func NewIndex[R record.Record](
+	field record.Field,
+	compute indexes.IndexComputer[R],
+	trie Storage,
+	unique bool,
+) indexes.Index[R] {
+	return index[R]{
+		field:   field,
+		unique:  unique,
+		compute: compute,
+		storage: indexes.CreateConcurrentStorage(trie, unique),
+	}
+}
@@ -0,0 +1,194 @@
+//nolint:exhaustruct
+package trie
+
+import (
+	"errors"
+	"regexp"
+	"sort"
+	"testing"
+
+	asserts "github.com/shamcode/assert"
+	"github.com/shamcode/simd/indexes"
+	"github.com/shamcode/simd/indexes/hash"
+	"github.com/shamcode/simd/record"
+	"github.com/shamcode/simd/where"
+	"github.com/shamcode/simd/where/comparators"
+)
+
+type _int64 []int64
+
+func (s _int64) Len() int           { return len(s) }
+func (s _int64) Less(i, j int) bool { return s[i] < s[j] }
+func (s _int64) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+type item struct {
+	id    int64
+	name  string
+	score int
+}
+
+func (i *item) GetID() int64 { return i.id }
+
+type items map[int64]*item
+
+func (i items) Get(id int64) (*item, bool) {
+	res, ok := i[id]
+	return res, ok
+}
+
+var fields = record.NewFields()
+
+var name = record.ComparableGetter[*item, string]{
+	Field: fields.New("name"),
+	Get:   func(item *item) string { return item.name },
+}
+
+var score = record.ComparableGetter[*item, int]{
+	Field: fields.New("score"),
+	Get:   func(item *item) int { return item.score },
+}
+
+func createIndex(data items) indexes.Index[*item] {
+	index := NewStringTrieIndex(name, false)
+
+	for _, item := range data {
+		index.ConcurrentStorage().GetOrCreate(index.Compute().ForRecord(item)).Add(item.id)
+	}
+
+	return index
+}
+
+func TestIndex(t *testing.T) {
+	index := createIndex(items{
+		1: {id: 1, name: "apple"},
+		2: {id: 2, name: "application"},
+		3: {id: 3, name: "apply"},
+		4: {id: 4, name: "banana"},
+		5: {id: 5, name: "apple"},
+	})
+
+	testCases := []struct {
+		condition      where.Condition[*item]
+		expectedWeight indexes.IndexWeight
+		expectedIDs    []int64
+	}{
+		{
+			condition: where.Condition[*item]{
+				Cmp: comparators.NewStringFieldComparator(where.StartsWith, name, "appl"),
+			},
+			expectedWeight: indexes.IndexWeightLow,
+			expectedIDs:    []int64{1, 2, 3, 5},
+		},
+		{
+			condition: where.Condition[*item]{
+				Cmp: comparators.NewStringFieldComparator(where.StartsWith, name, "apple"),
+			},
+			expectedWeight: indexes.IndexWeightLow,
+			expectedIDs:    []int64{1, 5},
+		},
+		{
+			condition: where.Condition[*item]{
+				WithNot: true,
+				Cmp:     comparators.NewStringFieldComparator(where.StartsWith, name, "appl"),
+			},
+			expectedWeight: indexes.IndexWeightHigh,
+			expectedIDs:    []int64{4},
+		},
+		{
+			condition: where.Condition[*item]{
+				Cmp: comparators.NewStringFieldRegexpComparator(where.Regexp, name, regexp.MustCompile(`^appl(e|y)$`)),
+			},
+			expectedWeight: indexes.IndexWeightLow,
+			expectedIDs:    []int64{1, 3, 5},
+		},
+		{
+			condition: where.Condition[*item]{
+				Cmp: comparators.NewStringFieldRegexpComparator(where.Regexp, name, regexp.MustCompile(`an+a`)),
+			},
+			expectedWeight: indexes.IndexWeightHigh,
+			expectedIDs:    []int64{4},
+		},
+		{
+			condition: where.Condition[*item]{
+				Cmp: comparators.NewStringFieldComparator(where.InArray, name, "apply", "banana", "cherry"),
+			},
+			expectedWeight: indexes.IndexWeightMedium,
+			expectedIDs:    []int64{3, 4},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.condition.String(), func(t *testing.T) {
+			canApply, weight := index.Weight(test.condition)
+			asserts.Equals(t, true, canApply, "can apply")
+			asserts.Equals(t, test.expectedWeight, weight, "weight")
+
+			count, idsStorage, err := index.Select(test.condition)
+			asserts.Success(t, err)
+
+			var ids []int64
+
+			for _, store := range idsStorage {
+				store.Iterate(func(id int64) {
+					ids = append(ids, id)
+				})
+			}
+
+			sort.Sort(_int64(ids))
+			asserts.Equals(t, test.expectedIDs, ids, "ids")
+			asserts.Equals(t, len(test.expectedIDs), count, "count")
+		})
+	}
+}
+
+func TestRegexpPrefix(t *testing.T) {
+	testCases := []struct {
+		pattern        string
+		expectedPrefix string
+		expectedOk     bool
+	}{
+		{pattern: `^abc.*`, expectedPrefix: "abc", expectedOk: true},
+		{pattern: `^abc`, expectedPrefix: "abc", expectedOk: true},
+		{pattern: `^ab(c|d)`, expectedPrefix: "ab", expectedOk: true},
+		{pattern: `^ab+`, expectedPrefix: "a", expectedOk: true},
+		{pattern: `abc`, expectedPrefix: "", expectedOk: false},
+		{pattern: `^(?i)abc`, expectedPrefix: "", expectedOk: false},
+		{pattern: `(?m)^abc`, expectedPrefix: "", expectedOk: false},
+		{pattern: `^.abc`, expectedPrefix: "", expectedOk: false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.pattern, func(t *testing.T) {
+			prefix, ok := RegexpPrefix(regexp.MustCompile(testCase.pattern))
+			asserts.Equals(t, testCase.expectedPrefix, prefix, "prefix")
+			asserts.Equals(t, testCase.expectedOk, ok, "ok")
+		})
+	}
+}
+
+func TestAutocomplete(t *testing.T) {
+	data := items{
+		1: {id: 1, name: "apple", score: 10},
+		2: {id: 2, name: "application", score: 30},
+		3: {id: 3, name: "apply", score: 5},
+		4: {id: 4, name: "banana", score: 100},
+		5: {id: 5, name: "apple", score: 20},
+		6: {id: 6, name: "appendix", score: 20},
+	}
+	index := createIndex(data)
+
+	completions, err := Autocomplete(index, data, score, "app", 3)
+	asserts.Success(t, err)
+	asserts.Equals(t, []Completion[int]{
+		{Value: "application", Score: 30, Count: 1},
+		{Value: "apple", Score: 20, Count: 2},
+		{Value: "appendix", Score: 20, Count: 1},
+	}, completions, "completions")
+
+	completions, err = Autocomplete(index, data, score, "x", 3)
+	asserts.Success(t, err)
+	asserts.Equals(t, 0, len(completions), "completions for unknown prefix")
+
+	_, err = Autocomplete(hash.NewComparableHashIndex(name, false), data, score, "app", 3)
+	asserts.Equals(t, true, errors.Is(err, ErrNotTrieIndex), "error for not trie index")
+}
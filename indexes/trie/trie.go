@@ -0,0 +1,160 @@
+package trie
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/shamcode/simd/indexes"
+	"github.com/shamcode/simd/indexes/compute"
+	"github.com/shamcode/simd/storage"
+)
+
+// node of radix tree, label is a part of key from parent node.
+type node struct {
+	label    string
+	children []*node // sorted by first byte of label
+	records  storage.IDStorage
+}
+
+// child returns index of child with label started from c, or position for insert new child.
+func (n *node) child(c byte) (int, *node) {
+	i := sort.Search(len(n.children), func(i int) bool {
+		return n.children[i].label[0] >= c
+	})
+
+	if i < len(n.children) && n.children[i].label[0] == c {
+		return i, n.children[i]
+	}
+
+	return i, nil
+}
+
+func (n *node) walk(key string, callback func(key string, records storage.IDStorage)) {
+	if nil != n.records {
+		callback(key, n.records)
+	}
+
+	for _, child := range n.children {
+		child.walk(key+child.label, callback)
+	}
+}
+
+type radixTree struct {
+	root *node
+}
+
+func keyValue(key indexes.Key) string {
+	return key.(compute.ComparableKey[string]).Value
+}
+
+func (t *radixTree) Get(key indexes.Key) storage.IDStorage {
+	n := t.root
+
+	for value := keyValue(key); value != ""; {
+		_, child := n.child(value[0])
+		if nil == child || !strings.HasPrefix(value, child.label) {
+			return nil
+		}
+
+		value = value[len(child.label):]
+		n = child
+	}
+
+	return n.records
+}
+
+func (t *radixTree) Set(key indexes.Key, records storage.IDStorage) {
+	n := t.root
+
+	for value := keyValue(key); value != ""; {
+		i, child := n.child(value[0])
+		if nil == child {
+			n.children = append(n.children, nil)
+			copy(n.children[i+1:], n.children[i:])
+			n.children[i] = &node{
+				label:    value,
+				children: nil,
+				records:  records,
+			}
+
+			return
+		}
+
+		common := commonPrefixLength(value, child.label)
+		if common < len(child.label) {
+			// Split edge: common part becomes new node with the child
+			split := &node{
+				label:    child.label[:common],
+				children: []*node{child},
+				records:  nil,
+			}
+			child.label = child.label[common:]
+			n.children[i] = split
+			child = split
+		}
+
+		value = value[common:]
+		n = child
+	}
+
+	n.records = records
+}
+
+// WalkPrefix calls callback for all keys started with prefix in lexicographical order.
+func (t *radixTree) WalkPrefix(prefix string, callback func(key string, records storage.IDStorage)) {
+	n := t.root
+	key := ""
+
+	for prefix != "" {
+		_, child := n.child(prefix[0])
+
+		switch {
+		case nil == child:
+			return
+		case strings.HasPrefix(prefix, child.label):
+			prefix = prefix[len(child.label):]
+		case strings.HasPrefix(child.label, prefix):
+			// Prefix ends inside of label
+			prefix = ""
+		default:
+			return
+		}
+
+		key += child.label
+		n = child
+	}
+
+	n.walk(key, callback)
+}
+
+func (t *radixTree) Prefix(prefix string) (count int, ids []storage.IDIterator) { //nolint:nonamedreturns
+	t.WalkPrefix(prefix, func(_ string, records storage.IDStorage) {
+		if itemCount := records.Count(); itemCount > 0 {
+			count += itemCount
+
+			ids = append(ids, records)
+		}
+	})
+
+	return
+}
+
+func commonPrefixLength(a, b string) int {
+	i := 0
+	for i < len(a) && i < len(b) && a[i] == b[i] {
+		i++
+	}
+
+	return i
+}
+
+// NewTree creates radix tree for string keys (compute.ComparableKey[string]).
+func NewTree() Storage {
+	return &radixTree{
+		root: &node{
+			label:    "",
+			children: nil,
+			records:  nil,
+		},
+	}
+}
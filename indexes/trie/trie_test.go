@@ -0,0 +1,64 @@
+package trie
+
+import (
+	"testing"
+
+	asserts "github.com/shamcode/assert"
+	"github.com/shamcode/simd/indexes/compute"
+	"github.com/shamcode/simd/storage"
+)
+
+func TestTree(t *testing.T) {
+	tree := NewTree()
+
+	values := []string{"romane", "romanus", "romulus", "rubens", "ruber", "rubicon", "rubicundus", "rom", ""}
+	for i, value := range values {
+		ids := storage.CreateSetIDStorage()
+		ids.Add(int64(i + 1))
+		tree.Set(compute.ComparableKey[string]{Value: value}, ids)
+	}
+
+	t.Run("get", func(t *testing.T) {
+		for i, value := range values {
+			ids := tree.Get(compute.ComparableKey[string]{Value: value})
+			asserts.Equals(t, 1, ids.Count(), "count for "+value)
+
+			ids.Iterate(func(id int64) {
+				asserts.Equals(t, int64(i+1), id, "id for "+value)
+			})
+		}
+
+		for _, value := range []string{"r", "roma", "romanes", "x"} {
+			asserts.Equals(t, true, nil == tree.Get(compute.ComparableKey[string]{Value: value}), "missed "+value)
+		}
+	})
+
+	t.Run("walk prefix", func(t *testing.T) {
+		testCases := []struct {
+			prefix   string
+			expected []string
+		}{
+			{prefix: "rom", expected: []string{"rom", "romane", "romanus", "romulus"}},
+			{prefix: "roma", expected: []string{"romane", "romanus"}},
+			{prefix: "rubi", expected: []string{"rubicon", "rubicundus"}},
+			{prefix: "rubicons", expected: nil},
+			{prefix: "x", expected: nil},
+			{
+				prefix:   "",
+				expected: []string{"", "rom", "romane", "romanus", "romulus", "rubens", "ruber", "rubicon", "rubicundus"},
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.prefix, func(t *testing.T) {
+				var keys []string
+
+				tree.WalkPrefix(testCase.prefix, func(key string, _ storage.IDStorage) {
+					keys = append(keys, key)
+				})
+
+				asserts.Equals(t, testCase.expected, keys, "keys")
+			})
+		}
+	})
+}
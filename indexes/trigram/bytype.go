@@ -0,0 +1,34 @@
+package trigram
+
+import (
+	"github.com/shamcode/simd/indexes"
+	"github.com/shamcode/simd/indexes/compute"
+	"github.com/shamcode/simd/record"
+)
+
+// NewStringTrigramIndex creates trigram index for substring search (where.Like, where.Regexp) by string field.
+func NewStringTrigramIndex[R record.Record](
+	getter record.GetterInterface[R, string],
+	unique bool,
+) indexes.Index[R] {
+	return NewIndex(
+		getter,
+		compute.CreateIndexComputation(getter),
+		NewTrigramList(),
+		unique,
+	)
+}
+
+// NewFoldedStringTrigramIndex creates trigram index with case- and accent-folded keys for conditions
+// of query.FieldFolded.
+func NewFoldedStringTrigramIndex[R record.Record](
+	getter record.GetterInterface[R, string],
+	unique bool,
+) indexes.Index[R] {
+	return NewIndex(
+		getter,
+		compute.CreateFoldedStringIndexComputation(getter),
+		NewTrigramList(),
+		unique,
+	)
+}
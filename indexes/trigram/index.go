@@ -0,0 +1,136 @@
+//nolint:exhaustive,nonamedreturns
+package trigram
+
+import (
+	"regexp"
+
+	"github.com/shamcode/simd/indexes"
+	"github.com/shamcode/simd/record"
+	"github.com/shamcode/simd/storage"
+	"github.com/shamcode/simd/where"
+)
+
+type Storage interface {
+	indexes.Storage
+	Keys() []indexes.Key
+	Candidates(literals []string) ([]indexes.Key, bool)
+}
+
+type index[R record.Record] struct {
+	field   record.Field
+	unique  bool
+	compute indexes.IndexComputer[R]
+	storage indexes.ConcurrentStorage
+}
+
+func (idx index[R]) trigrams() Storage {
+	return idx.storage.Unwrap().(Storage)
+}
+
+func (idx index[R]) Field() record.Field {
+	return idx.field
+}
+
+func (idx index[R]) Unique() bool {
+	return idx.unique
+}
+
+func (idx index[R]) Compute() indexes.IndexComputer[R] {
+	return idx.compute
+}
+
+func (idx index[R]) Weight(condition where.Condition[R]) (canApplyIndex bool, weight indexes.IndexWeight) {
+	if !indexes.FoldingMatches(idx.compute, condition) {
+		return false, 0
+	}
+
+	if literals := idx.literals(condition); hasTrigrams(literals) {
+		// Trigram index optimal for substring search
+		return true, indexes.IndexWeightLow
+	}
+
+	if isEquality, exclude := indexes.EqualityCondition(condition); isEquality && !exclude {
+		return true, indexes.IndexWeightMedium
+	}
+
+	// For other condition index can apply, but not optimal
+	return true, indexes.IndexWeightHigh
+}
+
+// literals returns substrings, which must be contained in keys matched by Like and Regexp conditions.
+func (idx index[R]) literals(condition where.Condition[R]) []string {
+	if condition.WithNot {
+		return nil
+	}
+
+	switch condition.Cmp.GetType() {
+	case where.Like:
+		return []string{keyValue(idx.compute.ForValue(condition.Cmp.ValueAt(0)))}
+	case where.Regexp:
+		if re, ok := condition.Cmp.ValueAt(0).(*regexp.Regexp); ok {
+			return RequiredLiterals(re)
+		}
+	}
+
+	return nil
+}
+
+func hasTrigrams(literals []string) bool {
+	for _, literal := range literals {
+		if len(literal) >= size {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (idx index[R]) Select(condition where.Condition[R]) (count int, ids []storage.IDIterator, err error) {
+	if literals := idx.literals(condition); hasTrigrams(literals) {
+		idx.storage.RLock()
+		keys, _ := idx.trigrams().Candidates(literals)
+		idx.storage.RUnlock()
+
+		// Candidates contains all trigrams, but substrings must be checked
+		return indexes.SelectForKeys(idx.storage, idx.compute, keys, condition)
+	}
+
+	if isEquality, exclude := indexes.EqualityCondition(condition); isEquality && !exclude {
+		count, ids = indexes.SelectForValues(idx.storage, idx.compute, condition)
+		return
+	}
+
+	idx.storage.RLock()
+	keys := idx.trigrams().Keys()
+	idx.storage.RUnlock()
+
+	return indexes.SelectForKeys(idx.storage, idx.compute, keys, condition)
+}
+
+// SelectExcluded selects records with values of NE, NOT IN conditions for subtracting from all records.
+func (idx index[R]) SelectExcluded(condition where.Condition[R]) (
+	canApplyIndex bool,
+	count int,
+	ids []storage.IDIterator,
+	err error,
+) {
+	return indexes.SelectExcluded(idx.storage, idx.compute, condition)
+}
+
+func (idx index[R]) ConcurrentStorage() indexes.ConcurrentStorage {
+	return idx.storage
+}
+
+func NewIndex[R record.Record](
+	field record.Field,
+	compute indexes.IndexComputer[R],
+	trigrams Storage,
+	unique bool,
+) indexes.Index[R] {
+	return index[R]{
+		field:   field,
+		unique:  unique,
+		compute: compute,
+		storage: indexes.CreateConcurrentStorage(trigrams, unique),
+	}
+}
@@ -0,0 +1,173 @@
+//nolint:exhaustruct
+package trigram
+
+import (
+	"maps"
+	"regexp"
+	"slices"
+	"sort"
+	"testing"
+
+	asserts "github.com/shamcode/assert"
+	"github.com/shamcode/simd/indexes"
+	"github.com/shamcode/simd/indexes/compute"
+	"github.com/shamcode/simd/record"
+	"github.com/shamcode/simd/where"
+	"github.com/shamcode/simd/where/comparators"
+)
+
+type _int64 []int64
+
+func (s _int64) Len() int           { return len(s) }
+func (s _int64) Less(i, j int) bool { return s[i] < s[j] }
+func (s _int64) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+var name = record.ComparableGetter[record.Record, string]{
+	Field: record.NewFields().New("name"),
+	Get:   func(record.Record) string { return "" },
+}
+
+func TestIndex(t *testing.T) {
+	index := NewStringTrigramIndex(name, false)
+
+	for id, value := range []string{"alexander", "alexandra", "sandra", "xander", "al", "andrew", "sandra"} {
+		index.ConcurrentStorage().GetOrCreate(index.Compute().ForValue(value)).Add(int64(id + 1))
+	}
+
+	testCases := []struct {
+		condition      where.Condition[record.Record]
+		expectedWeight indexes.IndexWeight
+		expectedIDs    []int64
+	}{
+		{
+			condition: where.Condition[record.Record]{
+				Cmp: comparators.NewStringFieldComparator(where.Like, name, "andr"),
+			},
+			expectedWeight: indexes.IndexWeightLow,
+			expectedIDs:    []int64{2, 3, 6, 7},
+		},
+		{
+			condition: where.Condition[record.Record]{
+				Cmp: comparators.NewStringFieldComparator(where.Like, name, "xand"),
+			},
+			expectedWeight: indexes.IndexWeightLow,
+			expectedIDs:    []int64{1, 2, 4},
+		},
+		{
+			condition: where.Condition[record.Record]{
+				Cmp: comparators.NewStringFieldComparator(where.Like, name, "zzz"),
+			},
+			expectedWeight: indexes.IndexWeightLow,
+			expectedIDs:    nil,
+		},
+		{
+			condition: where.Condition[record.Record]{
+				Cmp: comparators.NewStringFieldComparator(where.Like, name, "al"),
+			},
+			expectedWeight: indexes.IndexWeightHigh,
+			expectedIDs:    []int64{1, 2, 5},
+		},
+		{
+			condition: where.Condition[record.Record]{
+				WithNot: true,
+				Cmp:     comparators.NewStringFieldComparator(where.Like, name, "and"),
+			},
+			expectedWeight: indexes.IndexWeightHigh,
+			expectedIDs:    []int64{5},
+		},
+		{
+			condition: where.Condition[record.Record]{
+				Cmp: comparators.NewStringFieldRegexpComparator(where.Regexp, name, regexp.MustCompile(`^a.*dra$`)),
+			},
+			expectedWeight: indexes.IndexWeightLow,
+			expectedIDs:    []int64{2},
+		},
+		{
+			condition: where.Condition[record.Record]{
+				Cmp: comparators.NewStringFieldRegexpComparator(where.Regexp, name, regexp.MustCompile(`(?i)SAND`)),
+			},
+			expectedWeight: indexes.IndexWeightHigh,
+			expectedIDs:    []int64{3, 7},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.condition.String(), func(t *testing.T) {
+			canApply, weight := index.Weight(test.condition)
+			asserts.Equals(t, true, canApply, "can apply")
+			asserts.Equals(t, test.expectedWeight, weight, "weight")
+
+			count, idsStorage, err := index.Select(test.condition)
+			asserts.Success(t, err)
+
+			var ids []int64
+
+			for _, store := range idsStorage {
+				store.Iterate(func(id int64) {
+					ids = append(ids, id)
+				})
+			}
+
+			sort.Sort(_int64(ids))
+			asserts.Equals(t, test.expectedIDs, ids, "ids")
+			asserts.Equals(t, len(test.expectedIDs), count, "count")
+		})
+	}
+
+	t.Run("candidates", func(t *testing.T) {
+		// Only "alexandra" contains both "dra" and "xan"
+		keys, ok := index.ConcurrentStorage().Unwrap().(Storage).Candidates([]string{"dra", "xan"})
+		asserts.Equals(t, true, ok, "ok")
+		asserts.Equals(t, []indexes.Key{compute.ComparableKey[string]{Value: "alexandra"}}, keys, "keys")
+
+		_, ok = index.ConcurrentStorage().Unwrap().(Storage).Candidates([]string{"ab"})
+		asserts.Equals(t, false, ok, "ok for short literal")
+	})
+}
+
+func TestRemove(t *testing.T) {
+	index := NewStringTrigramIndex(name, false)
+	trigrams := index.ConcurrentStorage().Unwrap().(*trigramList)
+
+	for id, value := range []string{"sandra", "andrew", "sandra"} {
+		index.ConcurrentStorage().GetOrCreate(index.Compute().ForValue(value)).Add(int64(id + 1))
+	}
+
+	sandra := index.Compute().ForValue("sandra")
+
+	index.ConcurrentStorage().Delete(sandra, 1)
+	asserts.Equals(t, 2, len(trigrams.documents), "documents while text has records")
+
+	index.ConcurrentStorage().Delete(sandra, 3)
+	asserts.Equals(t, 1, len(trigrams.documents), "documents")
+	asserts.Equals(t, []string{"and", "dre", "ndr", "rew"}, slices.Sorted(maps.Keys(trigrams.trigrams)), "trigrams")
+
+	keys, _ := trigrams.Candidates([]string{"andr"})
+	asserts.Equals(t, []indexes.Key{index.Compute().ForValue("andrew")}, keys, "candidates")
+
+	index.ConcurrentStorage().Delete(index.Compute().ForValue("andrew"), 2)
+	asserts.Equals(t, 0, len(trigrams.documents), "documents after delete all")
+	asserts.Equals(t, 0, len(trigrams.trigrams), "trigrams after delete all")
+}
+
+func TestRequiredLiterals(t *testing.T) {
+	testCases := []struct {
+		pattern  string
+		expected []string
+	}{
+		{pattern: `abc`, expected: []string{"abc"}},
+		{pattern: `^abc.*def$`, expected: []string{"abc", "def"}},
+		{pattern: `(foo)bar`, expected: []string{"foobar"}},
+		{pattern: `ab(c|d)ef`, expected: []string{"ab", "ef"}},
+		{pattern: `x(abc)+y`, expected: []string{"x", "abc", "y"}},
+		{pattern: `a(bcd)?e`, expected: []string{"a", "e"}},
+		{pattern: `(?i)abc`, expected: nil},
+		{pattern: `\d+`, expected: nil},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.pattern, func(t *testing.T) {
+			asserts.Equals(t, testCase.expected, RequiredLiterals(regexp.MustCompile(testCase.pattern)), "literals")
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package trigram
+
+import (
+	"regexp"
+	"regexp/syntax"
+	"strings"
+)
+
+// RequiredLiterals returns literals, which must be contained in any text matched by regular expression,
+// e.g. "abc" and "def" for abc.*def. Case-insensitive parts and alternations aren't used.
+func RequiredLiterals(re *regexp.Regexp) []string {
+	parsed, err := syntax.Parse(re.String(), syntax.Perl)
+	if err != nil {
+		return nil
+	}
+
+	var (
+		literals []string
+		current  strings.Builder
+	)
+
+	flush := func() {
+		if current.Len() > 0 {
+			literals = append(literals, current.String())
+			current.Reset()
+		}
+	}
+
+	var walk func(node *syntax.Regexp)
+
+	walk = func(node *syntax.Regexp) {
+		switch node.Op { //nolint:exhaustive
+		case syntax.OpLiteral:
+			if node.Flags&syntax.FoldCase != 0 {
+				flush()
+				return
+			}
+
+			current.WriteString(string(node.Rune))
+		case syntax.OpConcat:
+			for _, sub := range node.Sub {
+				walk(sub)
+			}
+		case syntax.OpCapture:
+			walk(node.Sub[0])
+		case syntax.OpBeginText, syntax.OpEndText, syntax.OpBeginLine, syntax.OpEndLine,
+			syntax.OpWordBoundary, syntax.OpNoWordBoundary, syntax.OpEmptyMatch:
+			// Zero-width, literal continues
+		case syntax.OpPlus:
+			// At least one repetition is required, but literal can't continue over repetitions
+			flush()
+			walk(node.Sub[0])
+			flush()
+		case syntax.OpRepeat:
+			flush()
+
+			if node.Min > 0 {
+				walk(node.Sub[0])
+				flush()
+			}
+		default:
+			flush()
+		}
+	}
+
+	walk(parsed)
+	flush()
+
+	return literals
+}
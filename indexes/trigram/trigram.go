@@ -0,0 +1,162 @@
+package trigram
+
+import (
+	"github.com/shamcode/simd/indexes"
+	"github.com/shamcode/simd/indexes/compute"
+	"github.com/shamcode/simd/storage"
+)
+
+// size of n-gram in bytes.
+const size = 3
+
+// document is a distinct text of field, records contains IDs of records with the text.
+type document struct {
+	key     indexes.Key
+	records storage.IDStorage
+}
+
+type trigramList struct {
+	documents map[string]*document
+	trigrams  map[string]map[*document]struct{}
+}
+
+func keyValue(key indexes.Key) string {
+	return key.(compute.ComparableKey[string]).Value
+}
+
+// trigrams returns unique trigrams of text.
+func trigrams(text string) []string {
+	if len(text) < size {
+		return nil
+	}
+
+	res := make([]string, 0, len(text)-size+1)
+	seen := make(map[string]struct{}, len(text)-size+1)
+
+	for i := 0; i+size <= len(text); i++ {
+		trigram := text[i : i+size]
+		if _, ok := seen[trigram]; !ok {
+			seen[trigram] = struct{}{}
+			res = append(res, trigram)
+		}
+	}
+
+	return res
+}
+
+func (idx *trigramList) Get(key indexes.Key) storage.IDStorage {
+	if doc, ok := idx.documents[keyValue(key)]; ok {
+		return doc.records
+	}
+
+	return nil
+}
+
+func (idx *trigramList) Set(key indexes.Key, records storage.IDStorage) {
+	text := keyValue(key)
+	if doc, ok := idx.documents[text]; ok {
+		doc.records = records
+		return
+	}
+
+	doc := &document{
+		key:     key,
+		records: records,
+	}
+	idx.documents[text] = doc
+
+	for _, trigram := range trigrams(text) {
+		byTrigram, ok := idx.trigrams[trigram]
+		if !ok {
+			byTrigram = make(map[*document]struct{})
+			idx.trigrams[trigram] = byTrigram
+		}
+
+		byTrigram[doc] = struct{}{}
+	}
+}
+
+// Remove removes the text and its trigrams, which aren't contained in other texts.
+func (idx *trigramList) Remove(key indexes.Key) {
+	text := keyValue(key)
+
+	doc, ok := idx.documents[text]
+	if !ok {
+		return
+	}
+
+	delete(idx.documents, text)
+
+	for _, trigram := range trigrams(text) {
+		byTrigram := idx.trigrams[trigram]
+		delete(byTrigram, doc)
+
+		if len(byTrigram) == 0 {
+			delete(idx.trigrams, trigram)
+		}
+	}
+}
+
+func (idx *trigramList) Keys() []indexes.Key {
+	keys := make([]indexes.Key, 0, len(idx.documents))
+	for _, doc := range idx.documents {
+		keys = append(keys, doc.key)
+	}
+
+	return keys
+}
+
+// Candidates returns keys, which contain all trigrams of literals. Literals shorter than trigram are ignored,
+// ok is false if no literal has trigrams: candidates can't be narrowed.
+func (idx *trigramList) Candidates(literals []string) (keys []indexes.Key, ok bool) { //nolint:nonamedreturns
+	var required []map[*document]struct{}
+
+	for _, literal := range literals {
+		for _, trigram := range trigrams(literal) {
+			byTrigram, exists := idx.trigrams[trigram]
+			if !exists {
+				return nil, true
+			}
+
+			required = append(required, byTrigram)
+		}
+	}
+
+	if len(required) == 0 {
+		return nil, false
+	}
+
+	// Intersect from the shortest posting list
+	shortest := 0
+	for i, byTrigram := range required {
+		if len(byTrigram) < len(required[shortest]) {
+			shortest = i
+		}
+	}
+
+	for doc := range required[shortest] {
+		if containsAll(required, doc) {
+			keys = append(keys, doc.key)
+		}
+	}
+
+	return keys, true
+}
+
+func containsAll(required []map[*document]struct{}, doc *document) bool {
+	for _, byTrigram := range required {
+		if _, ok := byTrigram[doc]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// NewTrigramList creates storage of trigram index, which keeps postings of trigrams for distinct texts.
+func NewTrigramList() Storage {
+	return &trigramList{
+		documents: make(map[string]*document),
+		trigrams:  make(map[string]map[*document]struct{}),
+	}
+}
@@ -0,0 +1,275 @@
+package jsonquery
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/shamcode/simd/query"
+	"github.com/shamcode/simd/record"
+	"github.com/shamcode/simd/registry"
+	"github.com/shamcode/simd/sort"
+	"github.com/shamcode/simd/where"
+)
+
+type decoder[R record.Record, B query.Builder[R, B]] struct {
+	builder query.Builder[R, B]
+	fields  *registry.Registry[R]
+	steps   []func()
+}
+
+func (d *decoder[R, B]) add(step func()) {
+	d.steps = append(d.steps, step)
+}
+
+func (d *decoder[R, B]) node(path string, node Node, nested bool) error {
+	groups := 0
+	for _, isSet := range []bool{node.And != nil, node.Or != nil, node.Not != nil, node.Field != ""} {
+		if isSet {
+			groups++
+		}
+	}
+
+	if groups != 1 {
+		return newPathError(path, ErrInvalidNode)
+	}
+
+	switch {
+	case node.And != nil:
+		return d.group(path+".and", node.And, false, nested)
+	case node.Or != nil:
+		return d.group(path+".or", node.Or, true, nested)
+	case node.Not != nil:
+		d.add(func() { d.builder.Not() })
+		return d.node(path+".not", *node.Not, true)
+	default:
+		return d.condition(path, node)
+	}
+}
+
+func (d *decoder[R, B]) group(path string, nodes []Node, isOr bool, nested bool) error {
+	if len(nodes) == 0 {
+		return newPathError(path, ErrEmptyGroup)
+	}
+
+	if len(nodes) == 1 {
+		return d.node(path+"[0]", nodes[0], nested)
+	}
+
+	if nested {
+		d.add(func() { d.builder.OpenBracket() })
+	}
+
+	for i, node := range nodes {
+		if i > 0 && isOr {
+			d.add(func() { d.builder.Or() })
+		}
+
+		if err := d.node(path+"["+strconv.Itoa(i)+"]", node, true); err != nil {
+			return err
+		}
+	}
+
+	if nested {
+		d.add(func() { d.builder.CloseBracket() })
+	}
+
+	return nil
+}
+
+func (d *decoder[R, B]) condition(path string, node Node) error {
+	field, ok := d.fields.Get(node.Field)
+	if !ok {
+		return newPathError(path+".field", registry.NewFieldNotFoundError(node.Field))
+	}
+
+	cmp, ok := Operators[node.Op]
+	if !ok {
+		return newPathError(path+".op", ErrUnknownOperator)
+	}
+
+	if !registry.ValidValuesCount(cmp, len(node.Values)) {
+		return newPathError(path+".values", ErrInvalidValuesCount)
+	}
+
+	values := make([]any, len(node.Values))
+	for i, value := range node.Values {
+		values[i] = normalizeNumber(value)
+
+		if name, isField := fieldReference(value); isField {
+			other, ok := d.fields.Get(name)
+			if !ok {
+				return newPathError(path+".values["+strconv.Itoa(i)+"]", registry.NewFieldNotFoundError(name))
+			}
+
+			values[i] = other
+		}
+	}
+
+	if cmp == where.SliceAny {
+		elementCmp, ok := Operators[fmt.Sprint(values[0])]
+		if !ok || elementCmp == where.SliceAny {
+			return newPathError(path+".values[0]", ErrUnknownOperator)
+		}
+
+		values[0] = elementCmp
+	}
+
+	option := registry.Where(field, cmp, values...)
+	if option.Error != nil {
+		var convertErr registry.ConvertValueError
+		if errors.As(option.Error, &convertErr) {
+			return newPathError(path+".values["+strconv.Itoa(convertErr.Index)+"]", convertErr)
+		}
+
+		return newPathError(path+".values", option.Error)
+	}
+
+	d.add(func() { d.builder.Where(option) })
+
+	return nil
+}
+
+func (d *decoder[R, B]) sorting(path string, sorting []Sort) error {
+	for i, item := range sorting {
+		itemPath := path + "[" + strconv.Itoa(i) + "]"
+
+		field, ok := d.fields.Get(item.Field)
+		if !ok {
+			return newPathError(itemPath+".field", registry.NewFieldNotFoundError(item.Field))
+		}
+
+		by := field.Sort()
+		if nil == by {
+			return newPathError(itemPath+".field", ErrNotSortable)
+		}
+
+		var sortBy sort.ByWithOrder[R]
+
+		switch item.Order {
+		case "", OrderAsc:
+			sortBy = sort.Asc(by)
+		case OrderDesc:
+			sortBy = sort.Desc(by)
+		default:
+			return newPathError(itemPath+".order", ErrInvalidOrder)
+		}
+
+		d.add(func() { d.builder.Sort(sortBy) })
+	}
+
+	return nil
+}
+
+func (d *decoder[R, B]) decode(q Query) error {
+	if nil != q.Where {
+		if err := d.node("$.where", *q.Where, false); err != nil {
+			return err
+		}
+	}
+
+	if err := d.sorting("$.sort", q.Sort); err != nil {
+		return err
+	}
+
+	if q.Offset < 0 {
+		return newPathError("$.offset", ErrInvalidNumber)
+	}
+
+	if q.Offset > 0 {
+		d.add(func() { d.builder.Offset(q.Offset) })
+	}
+
+	if nil != q.Limit {
+		if *q.Limit < 0 {
+			return newPathError("$.limit", ErrInvalidNumber)
+		}
+
+		d.add(func() { d.builder.Limit(*q.Limit) })
+	}
+
+	return nil
+}
+
+// fieldReference checks that value is reference to other field: {"field": "name"}.
+func fieldReference(value any) (string, bool) {
+	object, ok := value.(map[string]any)
+	if !ok || len(object) != 1 {
+		return "", false
+	}
+
+	name, ok := object["field"].(string)
+
+	return name, ok
+}
+
+// normalizeNumber converts json.Number to int64 or float64.
+func normalizeNumber(value any) any {
+	number, ok := value.(json.Number)
+	if !ok {
+		return value
+	}
+
+	if res, err := number.Int64(); err == nil {
+		return res
+	}
+
+	if res, err := number.Float64(); err == nil {
+		return res
+	}
+
+	return value
+}
+
+// Apply applies conditions, sorting, offset and limit from JSON representation to the builder.
+// Builder isn't changed if the query contains error.
+func Apply[R record.Record, B query.Builder[R, B]](
+	builder query.Builder[R, B],
+	fields *registry.Registry[R],
+	q Query,
+) error {
+	d := &decoder[R, B]{
+		builder: builder,
+		fields:  fields,
+		steps:   nil,
+	}
+
+	if err := d.decode(q); err != nil {
+		return err
+	}
+
+	for _, step := range d.steps {
+		step()
+	}
+
+	return nil
+}
+
+// Build creates query from JSON representation.
+func Build[R record.Record](fields *registry.Registry[R], q Query) (query.Query[R], error) {
+	builder := query.NewBuilder[R]()
+	if err := Apply(builder, fields, q); err != nil {
+		return nil, err
+	}
+
+	res := builder.Query()
+
+	return res, res.Error()
+}
+
+// Decode decodes JSON document to the query. Unknown keys in document are rejected.
+func Decode[R record.Record](fields *registry.Registry[R], data []byte) (query.Query[R], error) {
+	var q Query
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&q); err != nil {
+		return nil, newPathError("$", err)
+	}
+
+	return Build(fields, q)
+}
@@ -0,0 +1,168 @@
+package jsonquery
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/shamcode/simd/query"
+	"github.com/shamcode/simd/record"
+	"github.com/shamcode/simd/sort"
+	"github.com/shamcode/simd/where"
+)
+
+type encoder[R record.Record] struct {
+	conditions where.Conditions[R]
+	pos        int
+}
+
+// group encodes conditions on the bracket level, opened is a count of entered brackets of the first condition.
+// Conditions on one level are applied from left to right, so node is extended while operation isn't changed.
+func (e *encoder[R]) group(opened, level int) (Node, error) {
+	var res Node
+
+	first := true
+
+	for e.pos < len(e.conditions) {
+		inGroup, nested, nestedOpened := e.conditions.Item(e.pos, opened, level)
+		if !inGroup {
+			break
+		}
+
+		opened = 0
+
+		var (
+			item Node
+			err  error
+		)
+
+		isOr := e.conditions[e.pos].IsOr
+
+		if nested {
+			item, err = e.group(nestedOpened, level+1)
+		} else {
+			item, err = e.condition(e.conditions[e.pos])
+			e.pos++
+		}
+
+		if err != nil {
+			return res, err
+		}
+
+		if first {
+			res = item
+			first = false
+
+			continue
+		}
+
+		res = combine(res, item, isOr)
+	}
+
+	return res, nil
+}
+
+func (e *encoder[R]) condition(condition where.Condition[R]) (Node, error) {
+	path := "$.conditions[" + strconv.Itoa(e.pos) + "]"
+
+	op, ok := operatorName(condition.Cmp.GetType())
+	if !ok || where.BoundsOf(condition.Cmp) != where.Inclusive || where.IsComputed(condition.Cmp) {
+		return Node{}, newPathError(path, fmt.Errorf("%w: %s", ErrNotEncodable, condition))
+	}
+
+	values := make([]any, condition.Cmp.ValuesCount())
+	for i := range values {
+		values[i] = condition.Cmp.ValueAt(i)
+		if condition.Cmp.GetType() == where.Regexp {
+			values[i] = fmt.Sprint(values[i])
+		}
+
+		if where.IsFieldToField(condition.Cmp) {
+			values[i] = map[string]any{"field": fmt.Sprint(values[i])}
+		}
+	}
+
+	if condition.Cmp.GetType() == where.SliceAny {
+		// The first value is operator for element
+		elementOp, ok := operatorName(values[0].(where.ComparatorType))
+		if !ok {
+			return Node{}, newPathError(path, fmt.Errorf("%w: %s", ErrNotEncodable, condition))
+		}
+
+		values[0] = elementOp
+	}
+
+	node := Node{ //nolint:exhaustruct
+		Field:  condition.Cmp.GetField().String(),
+		Op:     op,
+		Values: values,
+	}
+
+	if condition.WithNot {
+		return Node{Not: &node}, nil //nolint:exhaustruct
+	}
+
+	return node, nil
+}
+
+func combine(left, right Node, isOr bool) Node {
+	switch {
+	case isOr && left.Or != nil:
+		left.Or = append(left.Or, right)
+		return left
+	case isOr:
+		return Node{Or: []Node{left, right}} //nolint:exhaustruct
+	case left.And != nil:
+		left.And = append(left.And, right)
+		return left
+	default:
+		return Node{And: []Node{left, right}} //nolint:exhaustruct
+	}
+}
+
+// Encode encodes the query to JSON representation.
+func Encode[R record.Record](q query.Query[R]) (Query, error) {
+	res := Query{
+		Where:  nil,
+		Sort:   nil,
+		Limit:  nil,
+		Offset: q.Offset(),
+	}
+
+	if err := q.Error(); err != nil {
+		return res, err
+	}
+
+	if conditions := q.Conditions(); len(conditions) > 0 {
+		e := &encoder[R]{
+			conditions: conditions,
+			pos:        0,
+		}
+
+		node, err := e.group(0, 1)
+		if err != nil {
+			return res, err
+		}
+
+		res.Where = &node
+	}
+
+	for _, sortBy := range q.Sorting() {
+		by, desc := sort.Unwrap(sortBy)
+
+		order := OrderAsc
+		if desc {
+			order = OrderDesc
+		}
+
+		res.Sort = append(res.Sort, Sort{
+			Field: by.String(),
+			Order: order,
+		})
+	}
+
+	if limit, withLimit := q.Limit(); withLimit {
+		res.Limit = &limit
+	}
+
+	return res, nil
+}
@@ -0,0 +1,43 @@
+package jsonquery
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrInvalidNode        = errors.New("node must contain exactly one of: and, or, not, field")
+	ErrEmptyGroup         = errors.New("group must contain at least one node")
+	ErrUnknownOperator    = errors.New("unknown operator")
+	ErrInvalidValuesCount = errors.New("invalid values count")
+	ErrInvalidOrder       = errors.New("order must be asc or desc")
+	ErrNotSortable        = errors.New("field can't be used for sorting")
+	ErrInvalidNumber      = errors.New("must be non-negative")
+	ErrNotEncodable       = errors.New("condition can't be encoded to JSON")
+)
+
+// PathError is an error with path to invalid element of JSON document.
+type PathError struct {
+	Path string
+	Err  error
+}
+
+func (e PathError) Error() string {
+	return fmt.Sprintf("jsonquery: %s: %s", e.Path, e.Err.Error())
+}
+
+func (e PathError) Unwrap() error {
+	return e.Err
+}
+
+func (e PathError) Is(err error) bool {
+	_, ok := err.(PathError)
+	return ok
+}
+
+func newPathError(path string, err error) error {
+	return PathError{
+		Path: path,
+		Err:  err,
+	}
+}
@@ -0,0 +1,89 @@
+// Package jsonquery implements JSON representation of query.Query.
+//
+// Example:
+//
+//	{
+//	  "where": {"and": [
+//	    {"field": "status", "op": "in", "values": [1, 2]},
+//	    {"or": [
+//	      {"field": "name", "op": "like", "values": ["foo"]},
+//	      {"not": {"field": "is_online", "op": "eq", "values": [true]}}
+//	    ]}
+//	  ]},
+//	  "sort": [{"field": "score", "order": "desc"}],
+//	  "limit": 10,
+//	  "offset": 0
+//	}
+//
+// Field names are resolved through registry.Registry, values are checked against field types.
+// Value {"field": "name"} is reference to other field for comparing two fields of the same record.
+package jsonquery
+
+import "github.com/shamcode/simd/where"
+
+// Query is a JSON representation of query.Query.
+type Query struct {
+	Where  *Node  `json:"where,omitempty"`
+	Sort   []Sort `json:"sort,omitempty"`
+	Limit  *int   `json:"limit,omitempty"`
+	Offset int    `json:"offset,omitempty"`
+}
+
+// Node is a group of conditions (one of And, Or, Not) or a single condition (Field, Op, Values).
+type Node struct {
+	And    []Node `json:"and,omitempty"`
+	Or     []Node `json:"or,omitempty"`
+	Not    *Node  `json:"not,omitempty"`
+	Field  string `json:"field,omitempty"`
+	Op     string `json:"op,omitempty"`
+	Values []any  `json:"values,omitempty"`
+}
+
+// Sort is a sorting by the field.
+type Sort struct {
+	Field string `json:"field"`
+	Order string `json:"order,omitempty"`
+}
+
+const (
+	OrderAsc  = "asc"
+	OrderDesc = "desc"
+)
+
+// Operators maps operator name to comparator type.
+var Operators = map[string]where.ComparatorType{
+	"eq":                 where.EQ,
+	"ne":                 where.NE,
+	"gt":                 where.GT,
+	"ge":                 where.GE,
+	"lt":                 where.LT,
+	"le":                 where.LE,
+	"in":                 where.InArray,
+	"not_in":             where.NotInArray,
+	"like":               where.Like,
+	"regexp":             where.Regexp,
+	"set_has":            where.SetHas,
+	"set_has_any":        where.SetHasAny,
+	"set_has_all":        where.SetHasAll,
+	"map_has_key":        where.MapHasKey,
+	"map_key_value_eq":   where.MapKeyValueEQ,
+	"between":            where.Between,
+	"is_null":            where.IsNull,
+	"is_not_null":        where.IsNotNull,
+	"starts_with":        where.StartsWith,
+	"match":              where.Match,
+	"fuzzy":              where.Fuzzy,
+	"slice_contains":     where.SliceContains,
+	"slice_contains_all": where.SliceContainsAll,
+	"slice_any":          where.SliceAny,
+}
+
+func operatorName(cmp where.ComparatorType) (string, bool) {
+	for name, value := range Operators {
+		if value == cmp {
+			return name, true
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,425 @@
+//nolint:exhaustruct
+package jsonquery
+
+import (
+	"encoding/json"
+	"errors"
+	"regexp"
+	"testing"
+
+	asserts "github.com/shamcode/assert"
+	"github.com/shamcode/simd/executor"
+	"github.com/shamcode/simd/expr"
+	"github.com/shamcode/simd/namespace"
+	"github.com/shamcode/simd/query"
+	"github.com/shamcode/simd/record"
+	"github.com/shamcode/simd/registry"
+	"github.com/shamcode/simd/sort"
+	"github.com/shamcode/simd/where"
+)
+
+type user struct {
+	id       int64
+	name     string
+	status   uint8
+	score    int
+	maxScore int
+	isOnline bool
+	langs    []string
+}
+
+func (u *user) GetID() int64 { return u.id }
+
+var userFields = record.NewFields()
+
+var id = record.NewIDGetter[*user]()
+
+var name = record.ComparableGetter[*user, string]{
+	Field: userFields.New("name"),
+	Get:   func(item *user) string { return item.name },
+}
+
+var status = record.ComparableGetter[*user, uint8]{
+	Field: userFields.New("status"),
+	Get:   func(item *user) uint8 { return item.status },
+}
+
+var score = record.ComparableGetter[*user, int]{
+	Field: userFields.New("score"),
+	Get:   func(item *user) int { return item.score },
+}
+
+var maxScore = record.ComparableGetter[*user, int]{
+	Field: userFields.New("max_score"),
+	Get:   func(item *user) int { return item.maxScore },
+}
+
+var isOnline = record.BoolGetter[*user]{
+	Field: userFields.New("is_online"),
+	Get:   func(item *user) bool { return item.isOnline },
+}
+
+var langs = record.SliceGetter[*user, string]{
+	Field: userFields.New("langs"),
+	Get:   func(item *user) []string { return item.langs },
+}
+
+func createRegistry(t *testing.T) *registry.Registry[*user] {
+	t.Helper()
+
+	fields := registry.New[*user]()
+	asserts.Success(t, fields.Add(
+		registry.Comparable(id),
+		registry.Comparable(name),
+		registry.Comparable(status),
+		registry.Comparable(score),
+		registry.Comparable(maxScore),
+		registry.Bool(isOnline),
+		registry.Slice(langs),
+	))
+
+	return fields
+}
+
+func TestDecode(t *testing.T) {
+	fields := createRegistry(t)
+
+	store := namespace.CreateNamespace[*user]()
+	for _, item := range []*user{
+		{id: 1, name: "foo", status: 1, score: 10, maxScore: 10},
+		{id: 2, name: "foobar", status: 2, score: 20, maxScore: 50, isOnline: true},
+		{id: 3, name: "bar", status: 1, score: 30, isOnline: true},
+		{id: 4, name: "baz", status: 3, score: 40},
+	} {
+		asserts.Success(t, store.Insert(item))
+	}
+
+	testCases := []struct {
+		name          string
+		input         string
+		expectedIDs   []int64
+		expectedTotal int
+	}{
+		{
+			name: "nested groups",
+			input: `{
+				"where": {"and": [
+					{"field": "status", "op": "in", "values": [1, 2]},
+					{"or": [
+						{"field": "name", "op": "like", "values": ["foo"]},
+						{"not": {"field": "is_online", "op": "eq", "values": [true]}}
+					]}
+				]},
+				"sort": [{"field": "score", "order": "desc"}],
+				"limit": 10
+			}`,
+			expectedIDs:   []int64{2, 1},
+			expectedTotal: 2,
+		},
+		{
+			name: "range",
+			input: `{
+				"where": {"and": [
+					{"field": "score", "op": "ge", "values": [20]},
+					{"field": "score", "op": "lt", "values": [40.0]},
+					{"field": "score", "op": "between", "values": [0, 30]}
+				]},
+				"sort": [{"field": "ID"}]
+			}`,
+			expectedIDs:   []int64{2, 3},
+			expectedTotal: 2,
+		},
+		{
+			name: "or on top level",
+			input: `{
+				"where": {"or": [
+					{"field": "name", "op": "eq", "values": ["foo"]},
+					{"field": "name", "op": "regexp", "values": ["^ba"]}
+				]},
+				"sort": [{"field": "ID", "order": "desc"}],
+				"limit": 2
+			}`,
+			expectedIDs:   []int64{4, 3},
+			expectedTotal: 3,
+		},
+		{
+			name: "field to field",
+			input: `{
+				"where": {"field": "score", "op": "le", "values": [{"field": "max_score"}]},
+				"sort": [{"field": "ID"}]
+			}`,
+			expectedIDs:   []int64{1, 2},
+			expectedTotal: 2,
+		},
+		{
+			name: "not of group",
+			input: `{
+				"where": {"not": {"or": [
+					{"field": "status", "op": "eq", "values": [1]},
+					{"not": {"and": [
+						{"field": "score", "op": "gt", "values": [10]},
+						{"field": "is_online", "op": "eq", "values": [false]}
+					]}}
+				]}},
+				"sort": [{"field": "ID"}]
+			}`,
+			expectedIDs:   []int64{4},
+			expectedTotal: 1,
+		},
+		{
+			name:          "empty",
+			input:         `{"sort": [{"field": "ID"}]}`,
+			expectedIDs:   []int64{1, 2, 3, 4},
+			expectedTotal: 4,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			q, err := Decode(fields, []byte(testCase.input))
+			asserts.Success(t, err)
+
+			ctx := t.Context()
+			iter, total, err := executor.CreateQueryExecutor[*user](store).FetchAllAndTotal(ctx, q)
+			asserts.Success(t, err)
+
+			ids := make([]int64, 0, iter.Size())
+			for item := range iter.Seq(ctx) {
+				ids = append(ids, item.id)
+			}
+
+			asserts.Equals(t, testCase.expectedIDs, ids, "ids")
+			asserts.Equals(t, testCase.expectedTotal, total, "total")
+		})
+	}
+}
+
+func TestDecodeErrors(t *testing.T) {
+	fields := createRegistry(t)
+
+	testCases := []struct {
+		input         string
+		expectedError string
+		isError       error
+	}{
+		{
+			input:         `{"where": {"field": "unknown", "op": "eq", "values": [1]}}`,
+			expectedError: `jsonquery: $.where.field: field not found: unknown`,
+			isError:       registry.FieldNotFoundError{},
+		},
+		{
+			input:         `{"where": {"and": [{"field": "status", "op": "in", "values": [1, 256]}]}}`,
+			expectedError: `jsonquery: $.where.and[0].values[1]: cannot use 256 (int64) as uint8`,
+			isError:       registry.ConvertValueError{},
+		},
+		{
+			input:         `{"where": {"or": [{"field": "name", "op": "eq", "values": ["foo"]}, {"field": "name", "op": "like"}]}}`,
+			expectedError: `jsonquery: $.where.or[1].values: invalid values count`,
+			isError:       ErrInvalidValuesCount,
+		},
+		{
+			input:         `{"where": {"field": "name", "op": "contains", "values": ["a"]}}`,
+			expectedError: `jsonquery: $.where.op: unknown operator`,
+			isError:       ErrUnknownOperator,
+		},
+		{
+			input:         `{"where": {"field": "name", "op": "between", "values": ["a"]}}`,
+			expectedError: `jsonquery: $.where.values: invalid values count`,
+			isError:       ErrInvalidValuesCount,
+		},
+		{
+			input:         `{"where": {"field": "name", "op": "is_null", "values": ["a"]}}`,
+			expectedError: `jsonquery: $.where.values: invalid values count`,
+			isError:       ErrInvalidValuesCount,
+		},
+		{
+			input:         `{"where": {"field": "langs", "op": "slice_any", "values": ["has", "go"]}}`,
+			expectedError: `jsonquery: $.where.values[0]: unknown operator`,
+			isError:       ErrUnknownOperator,
+		},
+		{
+			input:         `{"where": {"field": "score", "op": "gt", "values": [{"field": "status"}]}}`,
+			expectedError: `jsonquery: $.where.values: score: incompatible field: status`,
+			isError:       registry.ErrIncompatibleField,
+		},
+		{
+			input:         `{"where": {"field": "score", "op": "gt", "values": [{"field": "bonus"}]}}`,
+			expectedError: `jsonquery: $.where.values[0]: field not found: bonus`,
+			isError:       registry.FieldNotFoundError{},
+		},
+		{
+			input:         `{"where": {"and": []}}`,
+			expectedError: `jsonquery: $.where.and: group must contain at least one node`,
+			isError:       ErrEmptyGroup,
+		},
+		{
+			input:         `{"where": {"not": {"field": "name", "op": "eq", "values": ["a"], "and": [{}]}}}`,
+			expectedError: `jsonquery: $.where.not: node must contain exactly one of: and, or, not, field`,
+			isError:       ErrInvalidNode,
+		},
+		{
+			input:         `{"sort": [{"field": "score", "order": "up"}]}`,
+			expectedError: `jsonquery: $.sort[0].order: order must be asc or desc`,
+			isError:       ErrInvalidOrder,
+		},
+		{
+			input:         `{"limit": -1}`,
+			expectedError: `jsonquery: $.limit: must be non-negative`,
+			isError:       ErrInvalidNumber,
+		},
+		{
+			input:         `{"unknown": 1}`,
+			expectedError: `jsonquery: $: json: unknown field "unknown"`,
+			isError:       PathError{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.input, func(t *testing.T) {
+			_, err := Decode(fields, []byte(testCase.input))
+			asserts.Equals(t, testCase.expectedError, err.Error(), "error")
+			asserts.Equals(t, true, errors.Is(err, testCase.isError), "is error")
+		})
+	}
+
+	t.Run("builder isn't changed on error", func(t *testing.T) {
+		builder := query.NewBuilder[*user]()
+		err := Apply(builder, fields, Query{
+			Where: &Node{Or: []Node{
+				{Field: "name", Op: "eq", Values: []any{"foo"}},
+				{Field: "score", Op: "eq", Values: []any{"bar"}},
+			}},
+		})
+		asserts.Equals(t, true, errors.Is(err, registry.ConvertValueError{}), "is error")
+		asserts.Equals(t, 0, len(builder.Query().Conditions()), "conditions count")
+	})
+}
+
+func TestEncode(t *testing.T) {
+	fields := createRegistry(t)
+
+	testCases := []struct {
+		name     string
+		query    query.Query[*user]
+		expected string
+	}{
+		{
+			name: "conditions",
+			query: query.NewBuilder[*user]().
+				Where(query.Field(status, where.InArray, 1, 2)).
+				OpenBracket().
+				Where(query.Field(name, where.Like, "foo")).
+				Or().
+				Not().
+				Where(query.FieldBool(isOnline, where.EQ, true)).
+				CloseBracket().
+				Sort(sort.Desc(score)).
+				Sort(sort.Asc(id)).
+				Offset(5).
+				Limit(10).
+				Query(),
+			expected: `{"where":{"and":[{"field":"status","op":"in","values":[1,2]},` +
+				`{"or":[{"field":"name","op":"like","values":["foo"]},` +
+				`{"not":{"field":"is_online","op":"eq","values":[true]}}]}]},` +
+				`"sort":[{"field":"score","order":"desc"},{"field":"ID","order":"asc"}],"limit":10,"offset":5}`,
+		},
+		{
+			name: "left to right",
+			query: query.NewBuilder[*user]().
+				Where(query.Field(id, where.EQ, 1)).
+				Or().
+				Where(query.Field(id, where.EQ, 2)).
+				Where(query.FieldStringRegexp(name, regexp.MustCompile("^f"))).
+				Query(),
+			expected: `{"where":{"and":[{"or":[{"field":"ID","op":"eq","values":[1]},` +
+				`{"field":"ID","op":"eq","values":[2]}]},{"field":"name","op":"regexp","values":["^f"]}]}}`,
+		},
+		{
+			name: "sibling groups",
+			query: query.NewBuilder[*user]().
+				OpenBracket().
+				Where(query.Field(id, where.EQ, 1)).
+				Or().
+				Where(query.Field(id, where.EQ, 2)).
+				CloseBracket().
+				OpenBracket().
+				Where(query.Field(score, where.GT, 1)).
+				Or().
+				Where(query.Field(score, where.LT, 0)).
+				CloseBracket().
+				Query(),
+			expected: `{"where":{"and":[{"or":[{"field":"ID","op":"eq","values":[1]},{"field":"ID","op":"eq","values":[2]}]},` +
+				`{"or":[{"field":"score","op":"gt","values":[1]},{"field":"score","op":"lt","values":[0]}]}]}}`,
+		},
+		{
+			name: "slice",
+			query: query.NewBuilder[*user]().
+				Where(query.FieldSlice(langs, where.SliceContainsAll, "go", "c")).
+				Where(query.FieldSliceAny(langs, where.InArray, "rust", "zig")).
+				Query(),
+			expected: `{"where":{"and":[{"field":"langs","op":"slice_contains_all","values":["go","c"]},` +
+				`{"field":"langs","op":"slice_any","values":["in","rust","zig"]}]}}`,
+		},
+		{
+			name: "field to field",
+			query: query.NewBuilder[*user]().
+				Where(query.FieldToField(score, where.GT, maxScore)).
+				Query(),
+			expected: `{"where":{"field":"score","op":"gt","values":[{"field":"max_score"}]}}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			encoded, err := Encode(testCase.query)
+			asserts.Success(t, err)
+
+			data, err := json.Marshal(encoded)
+			asserts.Success(t, err)
+			asserts.Equals(t, testCase.expected, string(data), "json")
+
+			decoded, err := Decode(fields, data)
+			asserts.Success(t, err)
+
+			reencoded, err := Encode(decoded)
+			asserts.Success(t, err)
+
+			data, err = json.Marshal(reencoded)
+			asserts.Success(t, err)
+			asserts.Equals(t, testCase.expected, string(data), "round trip")
+		})
+	}
+}
+
+func TestEncodeErrors(t *testing.T) {
+	testCases := []struct {
+		name  string
+		query query.Query[*user]
+	}{
+		{
+			name: "not inclusive range",
+			query: query.NewBuilder[*user]().
+				Where(query.FieldBetween(score, 1, 2, where.Exclusive)).
+				Query(),
+		},
+		{
+			name: "predicate",
+			query: query.NewBuilder[*user]().
+				Where(query.Predicate("is_online", func(item *user) (bool, error) { return item.isOnline, nil })).
+				Query(),
+		},
+		{
+			name: "expression",
+			query: query.NewBuilder[*user]().
+				Where(query.Expression(expr.Abs(expr.Field(score)), where.GT, expr.Value[*user](1))).
+				Query(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := Encode(testCase.query)
+			asserts.Equals(t, true, errors.Is(err, ErrNotEncodable), "is error")
+		})
+	}
+}
@@ -24,10 +24,18 @@ type fieldsComputer interface {
 	ComputeFields()
 }
 
+// Listener is notified about changes of records after indexes and storage are updated.
+type Listener[R record.Record] interface {
+	Insert(item R)
+	Delete(item R)
+	Update(oldItem, item R)
+}
+
 type WithIndexes[R record.Record] struct {
-	logger  Logger
-	storage storage.RecordsByID[R]
-	indexes indexes.ByField[R]
+	logger    Logger
+	storage   storage.RecordsByID[R]
+	indexes   indexes.ByField[R]
+	listeners []Listener[R]
 }
 
 func (ns *WithIndexes[R]) Get(id int64) (R, bool) {
@@ -51,6 +59,10 @@ func (ns *WithIndexes[R]) insert(item R) {
 
 	ns.indexes.Insert(item)
 	ns.storage.Set(item.GetID(), item)
+
+	for _, listener := range ns.listeners {
+		listener.Insert(item)
+	}
 }
 
 func (ns *WithIndexes[R]) Delete(id int64) error {
@@ -62,6 +74,10 @@ func (ns *WithIndexes[R]) Delete(id int64) error {
 	ns.indexes.Delete(item)
 	ns.storage.Delete(id)
 
+	for _, listener := range ns.listeners {
+		listener.Delete(item)
+	}
+
 	return nil
 }
 
@@ -83,6 +99,10 @@ func (ns *WithIndexes[R]) Upsert(item R) error {
 	ns.indexes.Update(oldItem, item)
 	ns.storage.Set(id, item)
 
+	for _, listener := range ns.listeners {
+		listener.Update(oldItem, item)
+	}
+
 	return nil
 }
 
@@ -90,78 +110,127 @@ func (ns *WithIndexes[R]) AddIndex(index indexes.Index[R]) {
 	ns.indexes.Add(index)
 }
 
-func (ns *WithIndexes[R]) PreselectForExecutor( //nolint:funlen,cyclop
+// AddListener adds listener for changes of records. AddListener isn't safe for concurrent use with writes.
+func (ns *WithIndexes[R]) AddListener(listener Listener[R]) {
+	ns.listeners = append(ns.listeners, listener)
+}
+
+func (ns *WithIndexes[R]) PreselectForExecutor(
 	ctx context.Context,
 	conditions where.Conditions[R],
 ) (
 	[]R,
 	error,
 ) {
-	byLevel := make(resultByBracketLevel)
-	lastBracketLevel := 0
+	if len(conditions) == 0 {
+		ns.logger.Println(ctx, "index not applied", conditions)
+		return ns.storage.GetAllData(), nil
+	}
 
-	for _, condition := range conditions {
-		var operation selectorOperation
-		if condition.IsOr {
-			operation = union
-		} else {
-			operation = intersection
+	res, _, err := ns.selectForGroup(conditions, 0, 0, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.size >= ns.storage.Count() {
+		ns.logger.Println(ctx, "index not applied (large select)", conditions)
+		return ns.storage.GetAllData(), nil
+	}
+
+	return ns.storage.GetData(res.items, res.size, res.idsUnique), nil
+}
+
+// selectForGroup selects records for group of conditions on the bracket level from pos
+// and returns position after the group.
+func (ns *WithIndexes[R]) selectForGroup(
+	conditions where.Conditions[R],
+	pos, opened, level int,
+) (result, int, error) {
+	var res result
+
+	first := true
+
+	for pos < len(conditions) {
+		inGroup, nested, nestedOpened := conditions.Item(pos, opened, level)
+		if !inGroup {
+			break
 		}
 
-		if lastBracketLevel > 0 {
-			last := byLevel[lastBracketLevel]
-			if nil != last && condition.BracketLevel >= lastBracketLevel && !last.operationRecognized {
-				last.operationRecognized = true
-				last.operation = operation
-			}
+		opened = 0
+		isOr := conditions[pos].IsOr
+
+		var (
+			item result
+			err  error
+		)
+
+		if nested {
+			item, pos, err = ns.selectForGroup(conditions, pos, nestedOpened, level+1)
+		} else {
+			item, err = ns.selectForItem(conditions[pos])
+			pos++
 		}
 
-		indexExists, indexSize, ids, idsUnique, err := ns.indexes.SelectForCondition(condition)
 		if err != nil {
-			return nil, err
+			return res, pos, err
 		}
 
-		if !indexExists {
-			all := ns.storage.GetIDStorage()
-			ids = append(ids, all)
-			indexSize = ns.storage.Count()
+		switch {
+		case first:
+			res = item
+			first = false
+		case isOr:
+			res.union(item)
+		default:
+			res.intersect(item)
 		}
+	}
 
-		if lastBracketLevel > condition.BracketLevel {
-			subLevelItems, subLevelSize, subLevelIDSUnique, hasItems := byLevel.reduce(lastBracketLevel, condition.BracketLevel)
-			if hasItems {
-				switch operation {
-				case union:
-					ids = append(ids, subLevelItems...)
-					indexSize += subLevelSize
-					idsUnique = false
-				case intersection:
-					if subLevelSize < indexSize {
-						ids = subLevelItems
-						indexSize = subLevelSize
-						idsUnique = subLevelIDSUnique
-					}
-				}
-			}
-		}
+	return res, pos, nil
+}
 
-		byLevel.save(condition.BracketLevel, ids, idsUnique, indexSize, operation)
-		lastBracketLevel = condition.BracketLevel
+// selectForItem selects records for the condition, all records are selected if index isn't exists.
+func (ns *WithIndexes[R]) selectForItem(condition where.Condition[R]) (result, error) {
+	indexExists, indexSize, ids, idsUnique, err := ns.selectForCondition(condition)
+	if err != nil {
+		return result{}, err //nolint:exhaustruct
 	}
 
-	items, size, idsUnique, hasItems := byLevel.reduce(lastBracketLevel, 0)
+	if !indexExists {
+		return result{
+			items:     []storage.IDIterator{ns.storage.GetIDStorage()},
+			size:      ns.storage.Count(),
+			idsUnique: true,
+		}, nil
+	}
 
-	if !hasItems {
-		ns.logger.Println(ctx, "index not applied", conditions)
-		return ns.storage.GetAllData(), nil
+	return result{
+		items:     ids,
+		size:      indexSize,
+		idsUnique: idsUnique,
+	}, nil
+}
+
+// selectForCondition selects records by index. Conditions like NE, NOT IN are selected as all records except
+// records with excluded values.
+func (ns *WithIndexes[R]) selectForCondition(condition where.Condition[R]) ( //nolint:nonamedreturns
+	indexExists bool,
+	count int,
+	ids []storage.IDIterator,
+	idsUnique bool,
+	err error,
+) {
+	excludedExists, excludedCount, excluded, err := ns.indexes.SelectExcludedForCondition(condition)
+	if err != nil {
+		return false, 0, nil, false, err
 	}
 
-	if size >= ns.storage.Count() {
-		ns.logger.Println(ctx, "index not applied (large select)", conditions)
-		return ns.storage.GetAllData(), nil
+	if excludedExists {
+		ids = []storage.IDIterator{storage.Except(ns.storage.GetIDStorage(), excluded)}
+		return true, ns.storage.Count() - excludedCount, ids, false, nil
 	}
 
-	return ns.storage.GetData(items, size, idsUnique), nil
+	return ns.indexes.SelectForCondition(condition)
 }
 
 func (ns *WithIndexes[R]) SetLogger(logger Logger) {
@@ -170,8 +239,9 @@ func (ns *WithIndexes[R]) SetLogger(logger Logger) {
 
 func CreateNamespace[R record.Record]() *WithIndexes[R] {
 	return &WithIndexes[R]{
-		logger:  StdLogger{},
-		storage: storage.CreateRecordsByID[R](),
-		indexes: indexes.CreateByField[R](),
+		logger:    StdLogger{},
+		storage:   storage.CreateRecordsByID[R](),
+		indexes:   indexes.CreateByField[R](),
+		listeners: nil,
 	}
 }
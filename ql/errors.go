@@ -0,0 +1,41 @@
+package ql
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrUnexpectedToken = errors.New("unexpected token")
+	ErrInvalidNumber   = errors.New("invalid number")
+	ErrInvalidString   = errors.New("invalid string")
+	ErrNotSortable     = errors.New("field can't be used for sorting")
+	ErrDuplicateClause = errors.New("duplicate clause")
+)
+
+type SyntaxError struct {
+	Pos   int
+	Token string
+	Err   error
+}
+
+func (e SyntaxError) Error() string {
+	return fmt.Sprintf("ql: syntax error at position %d near %q: %s", e.Pos, e.Token, e.Err.Error())
+}
+
+func (e SyntaxError) Unwrap() error {
+	return e.Err
+}
+
+func (e SyntaxError) Is(err error) bool {
+	_, ok := err.(SyntaxError)
+	return ok
+}
+
+func newSyntaxError(pos int, token string, err error) error {
+	return SyntaxError{
+		Pos:   pos,
+		Token: token,
+		Err:   err,
+	}
+}
@@ -0,0 +1,226 @@
+//nolint:exhaustruct
+package ql
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+type tokenKind uint8
+
+const (
+	tokenEOF tokenKind = iota + 1
+	tokenIdent
+	tokenNumber
+	tokenString
+	tokenOperator
+	tokenOpenBracket
+	tokenCloseBracket
+	tokenOpenSquareBracket
+	tokenCloseSquareBracket
+	tokenComma
+)
+
+type token struct {
+	kind  tokenKind
+	text  string
+	pos   int
+	value any
+}
+
+// is checks that token is the keyword, keywords are case-insensitive.
+func (t token) is(keyword string) bool {
+	return t.kind == tokenIdent && strings.EqualFold(t.text, keyword)
+}
+
+func (t token) String() string {
+	if t.kind == tokenEOF {
+		return "end of input"
+	}
+
+	return strconv.Quote(t.text)
+}
+
+type lexer struct {
+	input string
+	pos   int
+}
+
+func (l *lexer) tokens() ([]token, error) {
+	var tokens []token
+
+	for {
+		tok, err := l.next()
+		if err != nil {
+			return nil, err
+		}
+
+		tokens = append(tokens, tok)
+		if tok.kind == tokenEOF {
+			return tokens, nil
+		}
+	}
+}
+
+func (l *lexer) next() (token, error) { //nolint:cyclop
+	l.skipSpaces()
+
+	start := l.pos
+	if start >= len(l.input) {
+		return token{kind: tokenEOF, text: "", pos: start, value: nil}, nil
+	}
+
+	char := l.input[start]
+
+	switch {
+	case char == '(':
+		l.pos++
+		return l.token(tokenOpenBracket, start), nil
+	case char == ')':
+		l.pos++
+		return l.token(tokenCloseBracket, start), nil
+	case char == '[':
+		l.pos++
+		return l.token(tokenOpenSquareBracket, start), nil
+	case char == ']':
+		l.pos++
+		return l.token(tokenCloseSquareBracket, start), nil
+	case char == ',':
+		l.pos++
+		return l.token(tokenComma, start), nil
+	case char == '"' || char == '\'':
+		return l.string(char)
+	case char == '=':
+		l.pos++
+		return l.token(tokenOperator, start), nil
+	case char == '<' || char == '>' || char == '!':
+		l.pos++
+		if l.pos < len(l.input) && l.input[l.pos] == '=' {
+			l.pos++
+		} else if char == '!' {
+			return token{}, newSyntaxError(start, l.input[start:l.pos], ErrUnexpectedToken)
+		}
+
+		return l.token(tokenOperator, start), nil
+	case char == '-' || isDigit(char):
+		return l.number()
+	default:
+		r, _ := utf8.DecodeRuneInString(l.input[start:])
+		if !isIdentStart(r) {
+			return token{}, newSyntaxError(start, string(r), ErrUnexpectedToken)
+		}
+
+		l.skipWhile(isIdentPart)
+
+		return l.token(tokenIdent, start), nil
+	}
+}
+
+func (l *lexer) token(kind tokenKind, start int) token {
+	return token{
+		kind:  kind,
+		text:  l.input[start:l.pos],
+		pos:   start,
+		value: nil,
+	}
+}
+
+func (l *lexer) skipSpaces() {
+	l.skipWhile(unicode.IsSpace)
+}
+
+func (l *lexer) skipWhile(fn func(r rune) bool) {
+	for l.pos < len(l.input) {
+		r, size := utf8.DecodeRuneInString(l.input[l.pos:])
+		if !fn(r) {
+			return
+		}
+
+		l.pos += size
+	}
+}
+
+func (l *lexer) number() (token, error) {
+	start := l.pos
+	if l.input[l.pos] == '-' {
+		l.pos++
+	}
+
+	l.skipWhile(func(r rune) bool {
+		return isIdentPart(r) || r == '.' || r == '+' || r == '-'
+	})
+
+	tok := l.token(tokenNumber, start)
+
+	if value, err := strconv.ParseInt(tok.text, 10, 64); err == nil {
+		tok.value = value
+		return tok, nil
+	}
+
+	value, err := strconv.ParseFloat(tok.text, 64)
+	if err != nil {
+		return token{}, newSyntaxError(start, tok.text, ErrInvalidNumber)
+	}
+
+	tok.value = value
+
+	return tok, nil
+}
+
+func (l *lexer) string(quote byte) (token, error) {
+	start := l.pos
+	l.pos++
+
+	for l.pos < len(l.input) {
+		switch l.input[l.pos] {
+		case '\\':
+			if quote == '"' {
+				l.pos++
+			}
+
+			l.pos++
+		case quote:
+			l.pos++
+
+			tok := l.token(tokenString, start)
+			if quote == '\'' {
+				// SQL style: quote is escaped by doubling
+				if l.pos < len(l.input) && l.input[l.pos] == '\'' {
+					l.pos++
+					continue
+				}
+
+				tok.value = strings.ReplaceAll(tok.text[1:len(tok.text)-1], "''", "'")
+
+				return tok, nil
+			}
+
+			value, err := strconv.Unquote(tok.text)
+			if err != nil {
+				return token{}, newSyntaxError(start, tok.text, ErrInvalidString)
+			}
+
+			tok.value = value
+
+			return tok, nil
+		default:
+			l.pos++
+		}
+	}
+
+	return token{}, newSyntaxError(start, l.input[start:], ErrInvalidString)
+}
+
+func isDigit(char byte) bool {
+	return char >= '0' && char <= '9'
+}
+
+func isIdentStart(r rune) bool {
+	return r == '_' || unicode.IsLetter(r)
+}
+
+func isIdentPart(r rune) bool {
+	return r == '_' || r == '.' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
@@ -0,0 +1,520 @@
+package ql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/shamcode/simd/distance"
+	"github.com/shamcode/simd/query"
+	"github.com/shamcode/simd/record"
+	"github.com/shamcode/simd/registry"
+	"github.com/shamcode/simd/sort"
+	"github.com/shamcode/simd/where"
+)
+
+var operators = map[string]where.ComparatorType{
+	"=":  where.EQ,
+	">":  where.GT,
+	">=": where.GE,
+	"<":  where.LT,
+	"<=": where.LE,
+	"!=": where.NE,
+}
+
+// keywordOperators is operators with a single value, written as keyword.
+var keywordOperators = map[string]where.ComparatorType{
+	"LIKE":           where.Like,
+	"REGEXP":         where.Regexp,
+	"SET_HAS":        where.SetHas,
+	"MAP_HAS_KEY":    where.MapHasKey,
+	"STARTS_WITH":    where.StartsWith,
+	"MATCH":          where.Match,
+	"SLICE_CONTAINS": where.SliceContains,
+}
+
+// listOperators is operators with list of values in brackets, written as keyword.
+var listOperators = map[string]where.ComparatorType{
+	"SET_HAS_ANY":        where.SetHasAny,
+	"SET_HAS_ALL":        where.SetHasAll,
+	"SLICE_CONTAINS_ALL": where.SliceContainsAll,
+}
+
+type stepKind uint8
+
+const (
+	stepNot stepKind = iota + 1
+	stepOr
+	stepOpenBracket
+	stepCloseBracket
+	stepWhere
+	stepSort
+	stepOffset
+	stepLimit
+)
+
+// step is a single call of query.Builder method.
+type step[R record.Record] struct {
+	kind   stepKind
+	where  query.WhereOption[R]
+	sortBy sort.ByWithOrder[R]
+	value  int
+}
+
+type parser[R record.Record] struct {
+	fields *registry.Registry[R]
+	tokens []token
+	pos    int
+	steps  []step[R]
+}
+
+func (p *parser[R]) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser[R]) advance() token {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokenEOF {
+		p.pos++
+	}
+
+	return tok
+}
+
+func (p *parser[R]) unexpected(tok token, expected string) error {
+	return newSyntaxError(tok.pos, tok.text, fmt.Errorf("%w %s, expected %s", ErrUnexpectedToken, tok, expected))
+}
+
+func (p *parser[R]) expectKind(kind tokenKind, expected string) (token, error) {
+	tok := p.advance()
+	if tok.kind != kind {
+		return tok, p.unexpected(tok, expected)
+	}
+
+	return tok, nil
+}
+
+func (p *parser[R]) add(kind stepKind) {
+	p.steps = append(p.steps, step[R]{kind: kind}) //nolint:exhaustruct
+}
+
+func (p *parser[R]) isClauseEnd(tok token) bool {
+	return tok.kind == tokenEOF || tok.is("ORDER") || tok.is("LIMIT") || tok.is("OFFSET")
+}
+
+// parse parses: [WHERE expr] [ORDER BY sort {, sort}] [OFFSET n] [LIMIT n].
+func (p *parser[R]) parse() error {
+	if p.peek().is("WHERE") {
+		p.advance()
+
+		if err := p.parseExpr(); err != nil {
+			return err
+		}
+	} else if !p.isClauseEnd(p.peek()) {
+		if err := p.parseExpr(); err != nil {
+			return err
+		}
+	}
+
+	if p.peek().is("ORDER") {
+		p.advance()
+
+		if tok := p.advance(); !tok.is("BY") {
+			return p.unexpected(tok, "BY")
+		}
+
+		if err := p.parseSorting(); err != nil {
+			return err
+		}
+	}
+
+	return p.parseLimits()
+}
+
+func (p *parser[R]) parseLimits() error {
+	seen := make(map[stepKind]bool, 2)
+
+	for {
+		tok := p.advance()
+
+		var kind stepKind
+
+		switch {
+		case tok.kind == tokenEOF:
+			return nil
+		case tok.is("OFFSET"):
+			kind = stepOffset
+		case tok.is("LIMIT"):
+			kind = stepLimit
+		default:
+			return p.unexpected(tok, "ORDER BY, OFFSET, LIMIT or end of input")
+		}
+
+		if seen[kind] {
+			return newSyntaxError(tok.pos, tok.text, ErrDuplicateClause)
+		}
+
+		seen[kind] = true
+
+		number, err := p.expectKind(tokenNumber, "number")
+		if err != nil {
+			return err
+		}
+
+		value, ok := number.value.(int64)
+		if !ok || value < 0 {
+			return newSyntaxError(number.pos, number.text, ErrInvalidNumber)
+		}
+
+		p.steps = append(p.steps, step[R]{kind: kind, value: int(value)}) //nolint:exhaustruct
+	}
+}
+
+func (p *parser[R]) parseSorting() error {
+	for {
+		tok, err := p.expectKind(tokenIdent, "field name")
+		if err != nil {
+			return err
+		}
+
+		field, ok := p.fields.Get(tok.text)
+		if !ok {
+			return newSyntaxError(tok.pos, tok.text, registry.NewFieldNotFoundError(tok.text))
+		}
+
+		by := field.Sort()
+		if nil == by {
+			return newSyntaxError(tok.pos, tok.text, ErrNotSortable)
+		}
+
+		var sortBy sort.ByWithOrder[R]
+
+		switch next := p.peek(); {
+		case next.is("DESC"):
+			p.advance()
+
+			sortBy = sort.Desc(by)
+		case next.is("ASC"):
+			p.advance()
+
+			sortBy = sort.Asc(by)
+		default:
+			sortBy = sort.Asc(by)
+		}
+
+		p.steps = append(p.steps, step[R]{kind: stepSort, sortBy: sortBy}) //nolint:exhaustruct
+
+		if p.peek().kind != tokenComma {
+			return nil
+		}
+
+		p.advance()
+	}
+}
+
+// parseExpr parses: term {(AND | OR) term}.
+func (p *parser[R]) parseExpr() error {
+	if err := p.parseTerm(); err != nil {
+		return err
+	}
+
+	for {
+		switch tok := p.peek(); {
+		case tok.is("AND"):
+			p.advance()
+		case tok.is("OR"):
+			p.advance()
+			p.add(stepOr)
+		default:
+			return nil
+		}
+
+		if err := p.parseTerm(); err != nil {
+			return err
+		}
+	}
+}
+
+// parseTerm parses: {NOT} ( "(" expr ")" | condition ).
+func (p *parser[R]) parseTerm() error {
+	for p.peek().is("NOT") {
+		p.advance()
+		p.add(stepNot)
+	}
+
+	if p.peek().kind != tokenOpenBracket {
+		return p.parseCondition()
+	}
+
+	p.advance()
+	p.add(stepOpenBracket)
+
+	if err := p.parseExpr(); err != nil {
+		return err
+	}
+
+	if _, err := p.expectKind(tokenCloseBracket, "\")\""); err != nil {
+		return err
+	}
+
+	p.add(stepCloseBracket)
+
+	return nil
+}
+
+// parseCondition parses: field operator.
+func (p *parser[R]) parseCondition() error {
+	fieldToken, err := p.expectKind(tokenIdent, "field name or \"(\"")
+	if err != nil {
+		return err
+	}
+
+	field, ok := p.fields.Get(fieldToken.text)
+	if !ok {
+		return newSyntaxError(fieldToken.pos, fieldToken.text, registry.NewFieldNotFoundError(fieldToken.text))
+	}
+
+	cmp, values, err := p.parseOperator()
+	if err != nil {
+		return err
+	}
+
+	option := registry.Where(field, cmp, values...)
+	if option.Error != nil {
+		return newSyntaxError(fieldToken.pos, fieldToken.text, option.Error)
+	}
+
+	p.steps = append(p.steps, step[R]{kind: stepWhere, where: option}) //nolint:exhaustruct
+
+	return nil
+}
+
+// parseOperator parses: operator value | [NOT] IN "(" value {, value} ")" |
+// (SET_HAS_ANY | SET_HAS_ALL | SLICE_CONTAINS_ALL) "(" value {, value} ")" |
+// BETWEEN (value AND value | interval) | IS [NOT] NULL | FUZZY value value [LEVENSHTEIN | DAMERAU] |
+// MAP_KEY_VALUE_EQ value value | SLICE_ANY operator.
+func (p *parser[R]) parseOperator() (cmp where.ComparatorType, values []any, err error) { //nolint:nonamedreturns
+	tok := p.advance()
+
+	switch {
+	case tok.kind == tokenOperator && operators[tok.text] != 0:
+		cmp = operators[tok.text]
+		values, err = p.parseValues(1)
+	case tok.is("IN"):
+		cmp = where.InArray
+		values, err = p.parseList()
+	case tok.is("NOT") && p.peek().is("IN"):
+		p.advance()
+
+		cmp = where.NotInArray
+		values, err = p.parseList()
+	case tok.is("BETWEEN"):
+		cmp = where.Between
+		values, err = p.parseRange()
+	case tok.is("IS"):
+		cmp, err = p.parseNullCheck()
+	case tok.is("FUZZY"):
+		cmp = where.Fuzzy
+		values, err = p.parseFuzzy()
+	case tok.is("MAP_KEY_VALUE_EQ"):
+		cmp = where.MapKeyValueEQ
+		values, err = p.parseValues(2) //nolint:mnd
+	case tok.is("SLICE_ANY"):
+		cmp = where.SliceAny
+		values, err = p.parseElementOperator()
+	case tok.kind == tokenIdent && listOperators[strings.ToUpper(tok.text)] != 0:
+		cmp = listOperators[strings.ToUpper(tok.text)]
+		values, err = p.parseList()
+	case tok.kind == tokenIdent && keywordOperators[strings.ToUpper(tok.text)] != 0:
+		cmp = keywordOperators[strings.ToUpper(tok.text)]
+		values, err = p.parseValues(1)
+	default:
+		return 0, nil, p.unexpected(tok, "operator")
+	}
+
+	return cmp, values, err
+}
+
+// parseElementOperator parses operator for element of slice, values are type of operator and its values.
+func (p *parser[R]) parseElementOperator() ([]any, error) {
+	cmp, values, err := p.parseOperator()
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]any{cmp}, values...), nil
+}
+
+// parseFuzzy parses term, max edits and optional metric after FUZZY keyword.
+func (p *parser[R]) parseFuzzy() ([]any, error) {
+	values, err := p.parseValues(2) //nolint:mnd
+	if err != nil {
+		return nil, err
+	}
+
+	if metric := p.peek(); metric.is(distance.Levenshtein.String()) || metric.is(distance.Damerau.String()) {
+		p.advance()
+
+		values = append(values, metric.text)
+	}
+
+	return values, nil
+}
+
+// parseNullCheck parses [NOT] NULL after IS keyword.
+func (p *parser[R]) parseNullCheck() (where.ComparatorType, error) {
+	cmp := where.IsNull
+	if p.peek().is("NOT") {
+		p.advance()
+
+		cmp = where.IsNotNull
+	}
+
+	if tok := p.advance(); !tok.is("NULL") {
+		return 0, p.unexpected(tok, "NULL")
+	}
+
+	return cmp, nil
+}
+
+func (p *parser[R]) parseValues(count int) ([]any, error) {
+	values := make([]any, count)
+
+	for i := range count {
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+
+		values[i] = value
+	}
+
+	return values, nil
+}
+
+func (p *parser[R]) parseList() ([]any, error) {
+	if _, err := p.expectKind(tokenOpenBracket, "\"(\""); err != nil {
+		return nil, err
+	}
+
+	var values []any
+
+	for {
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+
+		values = append(values, value)
+
+		tok := p.advance()
+		switch tok.kind { //nolint:exhaustive
+		case tokenComma:
+			continue
+		case tokenCloseBracket:
+			return values, nil
+		default:
+			return nil, p.unexpected(tok, "\",\" or \")\"")
+		}
+	}
+}
+
+// parseRange parses: value AND value | interval.
+func (p *parser[R]) parseRange() ([]any, error) {
+	if kind := p.peek().kind; kind == tokenOpenBracket || kind == tokenOpenSquareBracket {
+		return p.parseInterval()
+	}
+
+	low, err := p.parseValue()
+	if err != nil {
+		return nil, err
+	}
+
+	if tok := p.advance(); !tok.is("AND") {
+		return nil, p.unexpected(tok, "AND")
+	}
+
+	high, err := p.parseValue()
+	if err != nil {
+		return nil, err
+	}
+
+	return []any{low, high}, nil
+}
+
+// parseInterval parses: ("[" | "(") value "," value ("]" | ")"), square bracket includes the bound
+// and round bracket excludes it, so [1, 3) is 1 <= value < 3. Bounds are returned after low and high values.
+func (p *parser[R]) parseInterval() ([]any, error) {
+	bounds := where.Inclusive
+	if p.advance().kind == tokenOpenBracket {
+		bounds |= where.ExcludeLow
+	}
+
+	low, err := p.parseValue()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = p.expectKind(tokenComma, "\",\""); err != nil {
+		return nil, err
+	}
+
+	high, err := p.parseValue()
+	if err != nil {
+		return nil, err
+	}
+
+	switch tok := p.advance(); tok.kind { //nolint:exhaustive
+	case tokenCloseBracket:
+		bounds |= where.ExcludeHigh
+	case tokenCloseSquareBracket:
+	default:
+		return nil, p.unexpected(tok, "\"]\" or \")\"")
+	}
+
+	return []any{low, high, bounds}, nil
+}
+
+func (p *parser[R]) parseValue() (any, error) {
+	tok := p.advance()
+
+	switch {
+	case tok.kind == tokenNumber || tok.kind == tokenString:
+		return tok.value, nil
+	case tok.is("TRUE"):
+		return true, nil
+	case tok.is("FALSE"):
+		return false, nil
+	case tok.kind == tokenIdent:
+		// Other field for comparing two fields
+		field, ok := p.fields.Get(tok.text)
+		if !ok {
+			return nil, newSyntaxError(tok.pos, tok.text, registry.NewFieldNotFoundError(tok.text))
+		}
+
+		return field, nil
+	default:
+		return nil, p.unexpected(tok, "value")
+	}
+}
+
+func parse[R record.Record](fields *registry.Registry[R], input string) ([]step[R], error) {
+	lex := &lexer{input: input, pos: 0}
+
+	tokens, err := lex.tokens()
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser[R]{
+		fields: fields,
+		tokens: tokens,
+		pos:    0,
+		steps:  nil,
+	}
+
+	if err := p.parse(); err != nil {
+		return nil, err
+	}
+
+	return p.steps, nil
+}
@@ -0,0 +1,63 @@
+// Package ql implements a small textual query language on top of query.Builder.
+//
+// Grammar is compatible with output of debug.WrapBuilder, e.g.:
+//
+//	status IN (1, 2) AND (name LIKE "foo" OR NOT is_online = true) ORDER BY score DESC LIMIT 10
+//
+// AND and OR have equal precedence and are applied from left to right, exactly as calls
+// of query.Builder, use brackets for grouping. Field names are resolved through registry.Registry.
+package ql
+
+import (
+	"github.com/shamcode/simd/query"
+	"github.com/shamcode/simd/record"
+	"github.com/shamcode/simd/registry"
+)
+
+// Apply parses input and applies conditions, sorting, offset and limit to the builder.
+// Builder isn't changed if input contains error.
+func Apply[R record.Record, B query.Builder[R, B]](
+	builder query.Builder[R, B],
+	fields *registry.Registry[R],
+	input string,
+) error {
+	steps, err := parse(fields, input)
+	if err != nil {
+		return err
+	}
+
+	for _, s := range steps {
+		switch s.kind {
+		case stepNot:
+			builder.Not()
+		case stepOr:
+			builder.Or()
+		case stepOpenBracket:
+			builder.OpenBracket()
+		case stepCloseBracket:
+			builder.CloseBracket()
+		case stepWhere:
+			builder.Where(s.where)
+		case stepSort:
+			builder.Sort(s.sortBy)
+		case stepOffset:
+			builder.Offset(s.value)
+		case stepLimit:
+			builder.Limit(s.value)
+		}
+	}
+
+	return nil
+}
+
+// Parse parses input to the query.
+func Parse[R record.Record](fields *registry.Registry[R], input string) (query.Query[R], error) {
+	builder := query.NewBuilder[R]()
+	if err := Apply(builder, fields, input); err != nil {
+		return nil, err
+	}
+
+	q := builder.Query()
+
+	return q, q.Error()
+}
@@ -0,0 +1,468 @@
+//nolint:exhaustruct
+package ql
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	asserts "github.com/shamcode/assert"
+	"github.com/shamcode/simd/debug"
+	"github.com/shamcode/simd/distance"
+	"github.com/shamcode/simd/executor"
+	"github.com/shamcode/simd/indexes/hash"
+	"github.com/shamcode/simd/indexes/trie"
+	"github.com/shamcode/simd/namespace"
+	"github.com/shamcode/simd/query"
+	"github.com/shamcode/simd/record"
+	"github.com/shamcode/simd/registry"
+	"github.com/shamcode/simd/sort"
+	"github.com/shamcode/simd/where"
+)
+
+type user struct {
+	id       int64
+	name     string
+	status   uint8
+	score    int
+	maxScore int
+	isOnline bool
+	level    *int
+	langs    []string
+	lastSeen time.Time
+}
+
+func (u *user) GetID() int64 { return u.id }
+
+var userFields = record.NewFields()
+
+var id = record.NewIDGetter[*user]()
+
+var name = record.ComparableGetter[*user, string]{
+	Field: userFields.New("name"),
+	Get:   func(item *user) string { return item.name },
+}
+
+var status = record.ComparableGetter[*user, uint8]{
+	Field: userFields.New("status"),
+	Get:   func(item *user) uint8 { return item.status },
+}
+
+var score = record.ComparableGetter[*user, int]{
+	Field: userFields.New("score"),
+	Get:   func(item *user) int { return item.score },
+}
+
+var maxScore = record.ComparableGetter[*user, int]{
+	Field: userFields.New("max_score"),
+	Get:   func(item *user) int { return item.maxScore },
+}
+
+var isOnline = record.BoolGetter[*user]{
+	Field: userFields.New("is_online"),
+	Get:   func(item *user) bool { return item.isOnline },
+}
+
+var level = record.NullableGetter[*user, int]{
+	Field: userFields.New("level"),
+	Get: func(item *user) (int, bool) {
+		if item.level == nil {
+			return 0, false
+		}
+
+		return *item.level, true
+	},
+}
+
+var langs = record.SliceGetter[*user, string]{
+	Field: userFields.New("langs"),
+	Get:   func(item *user) []string { return item.langs },
+}
+
+var lastSeen = record.TimeGetter[*user]{
+	Field: userFields.New("last_seen"),
+	Get:   func(item *user) time.Time { return item.lastSeen },
+}
+
+func createRegistry(t *testing.T) *registry.Registry[*user] {
+	t.Helper()
+
+	fields := registry.New[*user]()
+	asserts.Success(t, fields.Add(
+		registry.Comparable(id),
+		registry.Comparable(name),
+		registry.Comparable(status),
+		registry.Comparable(score),
+		registry.Comparable(maxScore),
+		registry.Bool(isOnline),
+		registry.Nullable(level),
+		registry.Slice(langs),
+		registry.Time(lastSeen),
+	))
+
+	return fields
+}
+
+func TestParse(t *testing.T) {
+	fields := createRegistry(t)
+
+	store := namespace.CreateNamespace[*user]()
+	store.AddIndex(hash.NewComparableHashIndex(status, false))
+	store.AddIndex(trie.NewStringTrieIndex(name, false))
+
+	for _, item := range []*user{
+		{id: 1, name: "foo", status: 1, score: 10, maxScore: 10, langs: []string{"go", "c"}},
+		{id: 2, name: "foobar", status: 2, score: 20, maxScore: 50, isOnline: true, level: &[]int{1}[0], langs: []string{"go"}},
+		{id: 3, name: "bar", status: 1, score: 30, isOnline: true, level: &[]int{2}[0], langs: []string{"rust"}},
+		{id: 4, name: "baz", status: 3, score: 40},
+	} {
+		asserts.Success(t, store.Insert(item))
+	}
+
+	testCases := []struct {
+		input         string
+		expectedIDs   []int64
+		expectedTotal int
+	}{
+		{
+			input:         `status IN (1,2) AND (name LIKE "foo" OR NOT is_online = true) ORDER BY score DESC LIMIT 10`,
+			expectedIDs:   []int64{2, 1},
+			expectedTotal: 2,
+		},
+		{
+			input:         ` WHERE score >= 20 AND score < 40 ORDER BY ID ASC`,
+			expectedIDs:   []int64{2, 3},
+			expectedTotal: 2,
+		},
+		{
+			input:         `name = 'foo' or name = "bar" order by ID desc`,
+			expectedIDs:   []int64{3, 1},
+			expectedTotal: 2,
+		},
+		{
+			input:         `name REGEXP "^ba" ORDER BY score`,
+			expectedIDs:   []int64{3, 4},
+			expectedTotal: 2,
+		},
+		{
+			input:         `ORDER BY ID DESC LIMIT 2`,
+			expectedIDs:   []int64{4, 3},
+			expectedTotal: 4,
+		},
+		{
+			input:         `score BETWEEN 20 AND 30 AND status = 1`,
+			expectedIDs:   []int64{3},
+			expectedTotal: 1,
+		},
+		{
+			input:         `status != 1 AND name NOT IN ("baz") ORDER BY ID`,
+			expectedIDs:   []int64{2},
+			expectedTotal: 1,
+		},
+		{
+			input:         `name STARTS_WITH "foo" OR name REGEXP "^ba[rz]$" ORDER BY ID`,
+			expectedIDs:   []int64{1, 2, 3, 4},
+			expectedTotal: 4,
+		},
+		{
+			input:         `name starts_with "foob"`,
+			expectedIDs:   []int64{2},
+			expectedTotal: 1,
+		},
+		{
+			input:         `level IS NULL ORDER BY ID`,
+			expectedIDs:   []int64{1, 4},
+			expectedTotal: 2,
+		},
+		{
+			input:         `level is not null AND level != 2 ORDER BY ID`,
+			expectedIDs:   []int64{2},
+			expectedTotal: 1,
+		},
+		{
+			input:         `name FUZZY "fo" 1 ORDER BY ID`,
+			expectedIDs:   []int64{1},
+			expectedTotal: 1,
+		},
+		{
+			input:         `name fuzzy "abr" 1 levenshtein OR name FUZZY "abr" 1 ORDER BY ID`,
+			expectedIDs:   []int64{3},
+			expectedTotal: 1,
+		},
+		{
+			input:         `langs SLICE_CONTAINS "go" AND langs SLICE_CONTAINS_ALL ("c", "go")`,
+			expectedIDs:   []int64{1},
+			expectedTotal: 1,
+		},
+		{
+			input:         `langs SLICE_ANY IN ("c", "rust") ORDER BY ID`,
+			expectedIDs:   []int64{1, 3},
+			expectedTotal: 2,
+		},
+		{
+			input:         `score < max_score OR NOT score != max_score ORDER BY ID`,
+			expectedIDs:   []int64{1, 2},
+			expectedTotal: 2,
+		},
+		{
+			input:         `NOT NOT is_online = TRUE ORDER BY ID`,
+			expectedIDs:   []int64{2, 3},
+			expectedTotal: 2,
+		},
+		{
+			input:         `NOT (status = 1 OR is_online = true) ORDER BY ID`,
+			expectedIDs:   []int64{4},
+			expectedTotal: 1,
+		},
+		{
+			input:         `NOT (NOT (score > 10 AND NOT name LIKE "bar") OR ID = 3) ORDER BY ID`,
+			expectedIDs:   []int64{4},
+			expectedTotal: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.input, func(t *testing.T) {
+			q, err := Parse(fields, testCase.input)
+			asserts.Success(t, err)
+
+			ctx := t.Context()
+			iter, total, err := executor.CreateQueryExecutor[*user](store).FetchAllAndTotal(ctx, q)
+			asserts.Success(t, err)
+
+			ids := make([]int64, 0, iter.Size())
+			for item := range iter.Seq(ctx) {
+				ids = append(ids, item.id)
+			}
+
+			asserts.Equals(t, testCase.expectedIDs, ids, "ids")
+			asserts.Equals(t, testCase.expectedTotal, total, "total")
+		})
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	fields := createRegistry(t)
+
+	testCases := []struct {
+		name  string
+		query query.Query[*user]
+	}{
+		{
+			name: "conditions",
+			query: debug.WrapBuilder(query.NewBuilder[*user]()).
+				Where(query.Field(status, where.InArray, 1, 2)).
+				OpenBracket().
+				Where(query.Field(name, where.Like, "f\"o")).
+				Or().
+				Not().
+				Where(query.FieldBool(isOnline, where.EQ, true)).
+				CloseBracket().
+				Sort(sort.Desc(score)).
+				Sort(sort.Asc(id)).
+				Offset(5).
+				Limit(10).
+				Query(),
+		},
+		{
+			name: "nested brackets",
+			query: debug.WrapBuilder(query.NewBuilder[*user]()).
+				OpenBracket().
+				OpenBracket().
+				Where(query.Field(id, where.EQ, 1)).
+				CloseBracket().
+				Or().
+				Where(query.Field(score, where.LE, -5)).
+				CloseBracket().
+				Where(query.Field(name, where.EQ, "baz")).
+				Query(),
+		},
+		{
+			name: "null check",
+			query: debug.WrapBuilder(query.NewBuilder[*user]()).
+				Where(query.FieldNullable(level, where.IsNull)).
+				Or().
+				Not().
+				Where(query.FieldNullable(level, where.IsNotNull)).
+				Query(),
+		},
+		{
+			name: "slice",
+			query: debug.WrapBuilder(query.NewBuilder[*user]()).
+				Where(query.FieldSlice(langs, where.SliceContains, "go")).
+				Where(query.FieldSlice(langs, where.SliceContainsAll, "go", "c")).
+				Or().
+				Where(query.FieldSliceAny(langs, where.Between, "a", "d")).
+				Query(),
+		},
+		{
+			name: "field to field",
+			query: debug.WrapBuilder(query.NewBuilder[*user]()).
+				Where(query.FieldToField(score, where.GE, maxScore)).
+				Or().
+				Not().
+				Where(query.FieldToField(maxScore, where.EQ, score)).
+				Query(),
+		},
+		{
+			name: "negated brackets",
+			query: debug.WrapBuilder(query.NewBuilder[*user]()).
+				Where(query.Field(id, where.EQ, 1)).
+				Or().
+				Not().
+				OpenBracket().
+				Where(query.Field(score, where.GT, 10)).
+				Not().
+				OpenBracket().
+				Where(query.Field(status, where.EQ, 1)).
+				Or().
+				Where(query.FieldBool(isOnline, where.EQ, true)).
+				CloseBracket().
+				CloseBracket().
+				Query(),
+		},
+		{
+			name: "time",
+			query: debug.WrapBuilder(query.NewBuilder[*user]()).
+				Where(query.FieldTime(lastSeen, where.GT, time.Date(2022, time.March, 1, 10, 0, 0, 500, time.UTC))).
+				Where(query.FieldTimeBetween(
+					lastSeen,
+					time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC),
+					time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC),
+					where.Inclusive,
+				)).
+				Sort(sort.Desc(lastSeen)).
+				Query(),
+		},
+		{
+			name: "between bounds",
+			query: debug.WrapBuilder(query.NewBuilder[*user]()).
+				Where(query.FieldBetween(score, 1, 3, where.Inclusive)).
+				Where(query.FieldBetween(score, 1, 3, where.ExcludeLow)).
+				Where(query.FieldBetween(score, 1, 3, where.ExcludeHigh)).
+				Or().
+				Where(query.FieldBetween(name, "a", "c", where.Exclusive)).
+				Where(query.FieldNullableBetween(level, 1, 5, where.ExcludeHigh)).
+				Where(query.FieldTimeBetween(
+					lastSeen,
+					time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC),
+					time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC),
+					where.ExcludeLow,
+				)).
+				Query(),
+		},
+		{
+			name: "fuzzy",
+			query: debug.WrapBuilder(query.NewBuilder[*user]()).
+				Where(query.FieldFuzzy(name, "fo\"o", 2, distance.Levenshtein)).
+				Where(query.FieldFuzzy(name, "baz", 1, distance.Damerau)).
+				Query(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dump := testCase.query.(debug.QueryWithDumper[*user]).String()
+
+			builder := debug.WrapBuilder(query.NewBuilder[*user]())
+			asserts.Success(t, Apply(builder, fields, dump))
+
+			parsed := builder.Query()
+			asserts.Success(t, parsed.Error())
+			asserts.Equals(t, dump, parsed.(debug.QueryWithDumper[*user]).String(), "dump")
+
+			expected := testCase.query.Conditions()
+			actual := parsed.Conditions()
+			asserts.Equals(t, len(expected), len(actual), "conditions count")
+
+			for i := range expected {
+				asserts.Equals(t, expected[i].String(), actual[i].String(), "condition")
+				asserts.Equals(t, expected[i].Cmp.ValuesCount(), actual[i].Cmp.ValuesCount(), "values count")
+				asserts.Equals(t, where.BoundsOf(expected[i].Cmp), where.BoundsOf(actual[i].Cmp), "bounds")
+
+				for j := range expected[i].Cmp.ValuesCount() {
+					asserts.Equals(t, expected[i].Cmp.ValueAt(j), actual[i].Cmp.ValueAt(j), "value")
+				}
+			}
+		})
+	}
+}
+
+func TestErrors(t *testing.T) {
+	fields := createRegistry(t)
+
+	testCases := []struct {
+		input         string
+		expectedError string
+		isError       error
+	}{
+		{
+			input:         `unknown = 1`,
+			expectedError: `ql: syntax error at position 0 near "unknown": field not found: unknown`,
+			isError:       registry.FieldNotFoundError{},
+		},
+		{
+			input:         `status = 1 AND`,
+			expectedError: `ql: syntax error at position 14 near "": unexpected token end of input, expected field name or "("`,
+			isError:       ErrUnexpectedToken,
+		},
+		{
+			input:         `level IS 1`,
+			expectedError: `ql: syntax error at position 9 near "1": unexpected token "1", expected NULL`,
+			isError:       ErrUnexpectedToken,
+		},
+		{
+			input:         `status = 256`,
+			expectedError: `ql: syntax error at position 0 near "status": status: cannot use 256 (int64) as uint8`,
+			isError:       registry.ConvertValueError{},
+		},
+		{
+			input:         `score BETWEEN [1, 3 AND`,
+			expectedError: `ql: syntax error at position 20 near "AND": unexpected token "AND", expected "]" or ")"`,
+			isError:       ErrUnexpectedToken,
+		},
+		{
+			input:         `name = "foo`,
+			expectedError: `ql: syntax error at position 7 near "\"foo": invalid string`,
+			isError:       ErrInvalidString,
+		},
+		{
+			input:         `is_online = true ORDER BY is_online LIMIT 1 LIMIT 2`,
+			expectedError: `ql: syntax error at position 44 near "LIMIT": duplicate clause`,
+			isError:       ErrDuplicateClause,
+		},
+		{
+			input:         `name FUZZY "foo" 1 HAMMING`,
+			expectedError: `ql: syntax error at position 19 near "HAMMING": unexpected token "HAMMING", expected ORDER BY, OFFSET, LIMIT or end of input`,
+			isError:       ErrUnexpectedToken,
+		},
+		{
+			input:         `score > status`,
+			expectedError: `ql: syntax error at position 0 near "score": score: incompatible field: status`,
+			isError:       registry.ErrIncompatibleField,
+		},
+		{
+			input:         `score > bonus`,
+			expectedError: `ql: syntax error at position 8 near "bonus": field not found: bonus`,
+			isError:       registry.FieldNotFoundError{},
+		},
+		{
+			input:         `is_online > true`,
+			expectedError: `ql: syntax error at position 0 near "is_online": is_online: unsupported type of condition: 2`,
+			isError:       registry.UnsupportedComparatorError{},
+		},
+		{
+			input:         `(score > 1`,
+			expectedError: `ql: syntax error at position 10 near "": unexpected token end of input, expected ")"`,
+			isError:       ErrUnexpectedToken,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.input, func(t *testing.T) {
+			_, err := Parse(fields, testCase.input)
+			asserts.Equals(t, testCase.expectedError, err.Error(), "error")
+			asserts.Equals(t, true, errors.Is(err, testCase.isError), "is error")
+		})
+	}
+}
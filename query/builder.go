@@ -6,6 +6,7 @@ import (
 	"github.com/shamcode/simd/record"
 	"github.com/shamcode/simd/sort"
 	"github.com/shamcode/simd/where"
+	"github.com/shamcode/simd/where/ast"
 )
 
 type Builder[R record.Record, B Builder[R, B]] interface { //nolint:interfacebloat
@@ -42,19 +43,29 @@ type Builder[R record.Record, B Builder[R, B]] interface { //nolint:interfaceblo
 }
 
 type BaseBuilder[R record.Record, Return Builder[R, Return]] struct {
-	limitItems   int
-	startOffset  int
-	withLimit    bool
-	withNot      bool
-	isOr         bool
-	conditionSet bool
-	bracketLevel int
-	where        where.Conditions[R]
-	sortBy       []sort.ByWithOrder[R]
-	onIteration  *func(item R)
-	errors       []error
-	onChain      Return
-	onCopy       func(cb Builder[R, Return]) Return
+	limitItems      int
+	startOffset     int
+	withLimit       bool
+	withNot         bool
+	isOr            bool
+	conditionSet    bool
+	bracketLevel    int
+	openBrackets    int
+	negatedBrackets []negatedBracket
+	where           where.Conditions[R]
+	slots           []Slot
+	sortBy          []sort.ByWithOrder[R]
+	onIteration     *func(item R)
+	errors          []error
+	onChain         Return
+	onCopy          func(cb Builder[R, Return]) Return
+}
+
+// negatedBracket is a bracket opened after .Not().
+type negatedBracket struct {
+	start        int // position of the first condition of the group
+	bracketLevel int // bracket level of the group
+	openBrackets int // count of brackets opened before the bracket
 }
 
 func (qb *BaseBuilder[R, Return]) Not() Return {
@@ -74,17 +85,33 @@ func (qb *BaseBuilder[R, Return]) Or() Return {
 
 func (qb *BaseBuilder[R, Return]) OpenBracket() Return {
 	if qb.withNot {
-		qb.errors = append(qb.errors, ErrNotOpenBracket)
+		qb.negatedBrackets = append(qb.negatedBrackets, negatedBracket{
+			start:        len(qb.where),
+			bracketLevel: qb.bracketLevel + 1,
+			openBrackets: qb.openBrackets,
+		})
+		qb.withNot = false
 	}
 
 	qb.conditionSet = false
 	qb.bracketLevel += 1
+	qb.openBrackets += 1
 
 	return qb.onChain
 }
 
 func (qb *BaseBuilder[R, Return]) CloseBracket() Return {
+	if last := len(qb.negatedBrackets) - 1; last >= 0 && qb.negatedBrackets[last].bracketLevel == qb.bracketLevel {
+		qb.negate(qb.negatedBrackets[last])
+		qb.negatedBrackets = qb.negatedBrackets[:last]
+	}
+
 	qb.bracketLevel -= 1
+	if qb.openBrackets > 0 {
+		// Empty brackets
+		qb.openBrackets -= 1
+	}
+
 	if qb.bracketLevel == -1 {
 		qb.errors = append(qb.errors, ErrCloseBracketWithoutOpen)
 	}
@@ -101,12 +128,15 @@ func (qb *BaseBuilder[R, Return]) Where(cmp WhereOption[R]) Return {
 		return qb.onChain
 	}
 
+	qb.slots = append(qb.slots, cmp.slots...)
 	qb.where = append(qb.where, where.Condition[R]{
 		WithNot:      qb.withNot,
 		IsOr:         qb.isOr,
 		BracketLevel: 1 + qb.bracketLevel,
+		OpenBrackets: qb.openBrackets,
 		Cmp:          cmp.Cmp,
 	})
+	qb.openBrackets = 0
 	qb.withNot = false
 	qb.isOr = false
 	qb.conditionSet = true
@@ -114,6 +144,36 @@ func (qb *BaseBuilder[R, Return]) Where(cmp WhereOption[R]) Return {
 	return qb.onChain
 }
 
+// negate replaces conditions of the negated group with equivalent conditions without NOT of the group.
+// NOT is pushed down to single conditions by De Morgan's laws: NOT (A OR B) is replaced by (NOT A AND NOT B).
+func (qb *BaseBuilder[R, Return]) negate(bracket negatedBracket) {
+	group := qb.where[bracket.start:]
+	if len(group) == 0 {
+		// Empty brackets
+		return
+	}
+
+	// Move the group to the top level, brackets opened before the group and the bracket of the group are dropped
+	conditions := make(where.Conditions[R], len(group))
+	for i, condition := range group {
+		condition.BracketLevel -= bracket.bracketLevel
+		conditions[i] = condition
+	}
+
+	conditions[0].IsOr = false
+	conditions[0].OpenBrackets -= bracket.openBrackets + 1
+
+	negated := ast.ToConditions[R](ast.Not[R]{Node: ast.FromConditions(conditions)})
+	for i := range negated {
+		negated[i].BracketLevel += bracket.bracketLevel
+	}
+
+	negated[0].IsOr = group[0].IsOr
+	negated[0].OpenBrackets += bracket.openBrackets + 1
+
+	qb.where = append(qb.where[:bracket.start], negated...)
+}
+
 func (qb *BaseBuilder[R, Return]) Sort(by sort.ByWithOrder[R]) Return {
 	qb.sortBy = append(qb.sortBy, by)
 
@@ -141,21 +201,26 @@ func (qb *BaseBuilder[R, Return]) OnIteration(fn func(item R)) Return {
 
 func (qb *BaseBuilder[R, Return]) MakeCopy() Return {
 	cpy := &BaseBuilder[R, Return]{
-		limitItems:   qb.limitItems,
-		startOffset:  qb.startOffset,
-		withLimit:    qb.withLimit,
-		withNot:      qb.withNot,
-		isOr:         qb.isOr,
-		conditionSet: qb.conditionSet,
-		bracketLevel: qb.bracketLevel,
-		where:        make(where.Conditions[R], len(qb.where)),
-		sortBy:       make([]sort.ByWithOrder[R], len(qb.sortBy)),
-		onIteration:  qb.onIteration,
-		errors:       make([]error, len(qb.errors)),
-		onChain:      qb.onChain,
-		onCopy:       qb.onCopy,
+		limitItems:      qb.limitItems,
+		startOffset:     qb.startOffset,
+		withLimit:       qb.withLimit,
+		withNot:         qb.withNot,
+		isOr:            qb.isOr,
+		conditionSet:    qb.conditionSet,
+		bracketLevel:    qb.bracketLevel,
+		openBrackets:    qb.openBrackets,
+		negatedBrackets: make([]negatedBracket, len(qb.negatedBrackets)),
+		where:           make(where.Conditions[R], len(qb.where)),
+		slots:           make([]Slot, len(qb.slots)),
+		sortBy:          make([]sort.ByWithOrder[R], len(qb.sortBy)),
+		onIteration:     qb.onIteration,
+		errors:          make([]error, len(qb.errors)),
+		onChain:         qb.onChain,
+		onCopy:          qb.onCopy,
 	}
+	copy(cpy.negatedBrackets, qb.negatedBrackets)
 	copy(cpy.where, qb.where)
+	copy(cpy.slots, qb.slots)
 	copy(cpy.sortBy, qb.sortBy)
 	copy(cpy.errors, qb.errors)
 
@@ -184,6 +249,7 @@ func (qb *BaseBuilder[R, Return]) Query() Query[R] {
 		limit:               qb.limitItems,
 		withLimit:           qb.withLimit,
 		conditions:          qb.where,
+		slots:               qb.slots,
 		sorting:             qb.sortBy,
 		onIterationCallback: qb.onIteration,
 		error:               errors.Join(qb.errors...),
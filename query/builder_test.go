@@ -23,16 +23,6 @@ func TestBuilderErrors(t *testing.T) {
 				Query(),
 			expectedError: ".Or() before any condition not supported, add any condition before .Or()",
 		},
-		{
-			query: NewBuilder[record.Record]().
-				Not().
-				OpenBracket().
-				Where(Field(_id, where.EQ, 1)).
-				Where(Field(_id, where.EQ, 2)).
-				CloseBracket().
-				Query(),
-			expectedError: ".Not().OpenBracket() not supported",
-		},
 		{
 			query: NewBuilder[record.Record]().
 				OpenBracket().
@@ -64,7 +54,6 @@ func TestBuilderErrors(t *testing.T) {
 				OpenBracket().
 				Query(),
 			expectedError: ".Or() before any condition not supported, add any condition before .Or()\n" +
-				".Not().OpenBracket() not supported\n" +
 				"invalid bracket balance: has not closed bracket",
 		},
 	}
@@ -73,3 +62,161 @@ func TestBuilderErrors(t *testing.T) {
 		asserts.Equals(t, testCase.expectedError, err, "check expected error")
 	}
 }
+
+// TestNotOpenBracket checks queries, which returned ErrNotOpenBracket before NOT of bracketed groups was supported.
+func TestNotOpenBracket(t *testing.T) {
+	id := record.NewIDGetter[*item]()
+
+	testCases := []struct {
+		name        string
+		query       Query[*item]
+		expectedIDs []int64
+	}{
+		{
+			name: "NOT (1 AND 2)",
+			query: NewBuilder[*item]().
+				Not().
+				OpenBracket().
+				Where(Field(id, where.EQ, 1)).
+				Where(Field(id, where.EQ, 2)).
+				CloseBracket().
+				Query(),
+			expectedIDs: []int64{1, 2, 3},
+		},
+		{
+			name: "NOT (1 OR 2)",
+			query: NewBuilder[*item]().
+				Not().
+				OpenBracket().
+				Where(Field(id, where.EQ, 1)).
+				Or().
+				Where(Field(id, where.EQ, 2)).
+				CloseBracket().
+				Query(),
+			expectedIDs: []int64{3},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			asserts.Success(t, testCase.query.Error())
+
+			var ids []int64
+
+			for _, it := range []*item{{id: 1, name: "foo"}, {id: 2, name: "bar"}, {id: 3, name: "baz"}} {
+				ok, err := testCase.query.Conditions().Check(it)
+				asserts.Success(t, err)
+
+				if ok {
+					ids = append(ids, it.id)
+				}
+			}
+
+			asserts.Equals(t, testCase.expectedIDs, ids, "ids")
+		})
+	}
+}
+
+func TestNotBracket(t *testing.T) {
+	_id := record.NewIDGetter[record.Record]()
+
+	type condition struct {
+		WithNot      bool
+		IsOr         bool
+		BracketLevel int
+		OpenBrackets int
+		Value        any
+	}
+
+	testCases := []struct {
+		name     string
+		builder  DefaultBuilder[record.Record]
+		expected []condition
+	}{
+		{
+			name: "NOT (1 OR 2)",
+			builder: NewBuilder[record.Record]().
+				Not().
+				OpenBracket().
+				Where(Field(_id, where.EQ, 1)).
+				Or().
+				Where(Field(_id, where.EQ, 2)).
+				CloseBracket(),
+			expected: []condition{
+				{WithNot: true, IsOr: false, BracketLevel: 2, OpenBrackets: 1, Value: int64(1)},
+				{WithNot: true, IsOr: false, BracketLevel: 2, OpenBrackets: 0, Value: int64(2)},
+			},
+		},
+		{
+			name: "1 OR NOT (2 AND NOT 3) AND 4",
+			builder: NewBuilder[record.Record]().
+				Where(Field(_id, where.EQ, 1)).
+				Or().
+				Not().
+				OpenBracket().
+				Where(Field(_id, where.EQ, 2)).
+				Not().
+				Where(Field(_id, where.EQ, 3)).
+				CloseBracket().
+				Where(Field(_id, where.EQ, 4)),
+			expected: []condition{
+				{WithNot: false, IsOr: false, BracketLevel: 1, OpenBrackets: 0, Value: int64(1)},
+				{WithNot: true, IsOr: true, BracketLevel: 2, OpenBrackets: 1, Value: int64(2)},
+				{WithNot: false, IsOr: true, BracketLevel: 2, OpenBrackets: 0, Value: int64(3)},
+				{WithNot: false, IsOr: false, BracketLevel: 1, OpenBrackets: 0, Value: int64(4)},
+			},
+		},
+		{
+			name: "(NOT (NOT (1 OR 2) AND 3))",
+			builder: NewBuilder[record.Record]().
+				OpenBracket().
+				Not().
+				OpenBracket().
+				Not().
+				OpenBracket().
+				Where(Field(_id, where.EQ, 1)).
+				Or().
+				Where(Field(_id, where.EQ, 2)).
+				CloseBracket().
+				Where(Field(_id, where.EQ, 3)).
+				CloseBracket().
+				CloseBracket(),
+			expected: []condition{
+				{WithNot: false, IsOr: false, BracketLevel: 3, OpenBrackets: 2, Value: int64(1)},
+				{WithNot: false, IsOr: true, BracketLevel: 3, OpenBrackets: 0, Value: int64(2)},
+				{WithNot: true, IsOr: true, BracketLevel: 3, OpenBrackets: 0, Value: int64(3)},
+			},
+		},
+		{
+			name: "NOT () 1",
+			builder: NewBuilder[record.Record]().
+				Not().
+				OpenBracket().
+				CloseBracket().
+				Where(Field(_id, where.EQ, 1)),
+			expected: []condition{
+				{WithNot: false, IsOr: false, BracketLevel: 1, OpenBrackets: 0, Value: int64(1)},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			q := testCase.builder.Query()
+			asserts.Success(t, q.Error())
+
+			conditions := make([]condition, len(q.Conditions()))
+			for i, cond := range q.Conditions() {
+				conditions[i] = condition{
+					WithNot:      cond.WithNot,
+					IsOr:         cond.IsOr,
+					BracketLevel: cond.BracketLevel,
+					OpenBrackets: cond.OpenBrackets,
+					Value:        cond.Cmp.ValueAt(0),
+				}
+			}
+
+			asserts.Equals(t, testCase.expected, conditions, "conditions")
+		})
+	}
+}
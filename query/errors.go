@@ -9,16 +9,30 @@ import (
 
 var (
 	ErrOrBeforeAnyConditions   = errors.New(".Or() before any condition not supported, add any condition before .Or()")
-	ErrNotOpenBracket          = errors.New(".Not().OpenBracket() not supported")
 	ErrCloseBracketWithoutOpen = errors.New("close bracket without open")
 	ErrInvalidBracketBalance   = errors.New("invalid bracket balance: has not closed bracket")
+	ErrDuplicateParameter      = errors.New("duplicate parameter")
+	ErrUnusedParameter         = errors.New("parameter isn't used in conditions")
+	ErrUnknownParameter        = errors.New("unknown parameter")
+	ErrParameterNotBound       = errors.New("parameter value not set")
+	ErrParameterNotPrepared    = errors.New("parameter of conditions isn't passed to Prepare")
+	ErrNotEnoughQueries        = errors.New("set operation requires at least two queries")
 )
 
+// ErrNotOpenBracket isn't returned by builder.
+//
+// Deprecated: .Not().OpenBracket() is supported, NOT of the group is pushed down to conditions of the group.
+var ErrNotOpenBracket = errors.New(".Not().OpenBracket() not supported")
+
 type (
 	GetterError struct {
 		Field record.Field
 		Err   error
 	}
+	ParameterError struct {
+		Name string
+		Err  error
+	}
 	CastError[A, B any] struct {
 		Expected A
 		Actual   B
@@ -33,6 +47,14 @@ func (e GetterError) Unwrap() error {
 	return e.Err
 }
 
+func (e ParameterError) Error() string {
+	return "parameter " + e.Name + ": " + e.Err.Error()
+}
+
+func (e ParameterError) Unwrap() error {
+	return e.Err
+}
+
 func (e CastError[A, B]) Error() string {
 	return fmt.Sprintf("cannot cast %T to %T", e.Actual, e.Expected)
 }
@@ -2,7 +2,10 @@ package query
 
 import (
 	"regexp"
+	"time"
 
+	"github.com/shamcode/simd/distance"
+	"github.com/shamcode/simd/expr"
 	"github.com/shamcode/simd/record"
 	"github.com/shamcode/simd/where"
 	"github.com/shamcode/simd/where/comparators"
@@ -11,6 +14,9 @@ import (
 type WhereOption[R record.Record] struct {
 	Cmp   where.FieldComparator[R]
 	Error error
+
+	// slots are values of Cmp set by parameters, see FieldParam
+	slots []Slot
 }
 
 func FieldAny[R record.Record](
@@ -25,6 +31,7 @@ func FieldAny[R record.Record](
 			Value:  values,
 		},
 		Error: nil,
+		slots: nil,
 	}
 }
 
@@ -40,6 +47,7 @@ func Field[R record.Record, T record.LessComparable](
 			return WhereOption[R]{
 				Cmp:   nil,
 				Error: GetterError{Field: getter.Field, Err: err},
+				slots: nil,
 			}
 		}
 
@@ -50,16 +58,105 @@ func Field[R record.Record, T record.LessComparable](
 				castedValue...,
 			),
 			Error: nil,
+			slots: nil,
 		}
 
 	default:
 		return WhereOption[R]{
 			Cmp:   comparators.NewComparableFieldComparator[R, T](condition, getter, value...),
 			Error: nil,
+			slots: nil,
 		}
 	}
 }
 
+// FieldToField compares values of two fields of the same record, for example updated_at > created_at.
+// Indexes aren't applied for the condition.
+func FieldToField[R record.Record, T record.LessComparable](
+	getter record.ComparableGetter[R, T],
+	condition where.ComparatorType,
+	other record.ComparableGetter[R, T],
+) WhereOption[R] {
+	return WhereOption[R]{
+		Cmp:   comparators.NewFieldsComparator(condition, getter, other),
+		Error: nil,
+		slots: nil,
+	}
+}
+
+// Predicate checks record by arbitrary function, name is used by debug dumps and must identify the function.
+// Indexes aren't applied for the condition.
+func Predicate[R record.Record](name string, fn func(item R) (bool, error)) WhereOption[R] {
+	return WhereOption[R]{
+		Cmp:   comparators.NewPredicateComparator(name, fn),
+		Error: nil,
+		slots: nil,
+	}
+}
+
+// Expression compares values of two expressions, for example score*2 + bonus > 100, see expr package.
+// Indexes aren't applied for the condition.
+func Expression[R record.Record, T record.LessComparable](
+	left expr.Expr[R, T],
+	condition where.ComparatorType,
+	right expr.Expr[R, T],
+) WhereOption[R] {
+	return WhereOption[R]{
+		Cmp:   comparators.NewExpressionComparator(condition, left, right),
+		Error: nil,
+		slots: nil,
+	}
+}
+
+// FieldBetween checks that field value is in range from low to high, bounds defines inclusion of low and high.
+func FieldBetween[R record.Record, T record.LessComparable](
+	getter record.ComparableGetter[R, T],
+	low, high T,
+	bounds where.Bounds,
+) WhereOption[R] {
+	option := Field(getter, where.Between, low, high)
+
+	switch cmp := option.Cmp.(type) {
+	case comparators.StringFieldComparator[R]:
+		cmp.Bounds = bounds
+		option.Cmp = cmp
+	case comparators.ComparableFieldComparator[R, T]:
+		cmp.Bounds = bounds
+		option.Cmp = cmp
+	}
+
+	return option
+}
+
+// FieldTime adds condition for time.Time field, instants of time are compared, so values may be in any location.
+func FieldTime[R record.Record](
+	getter record.TimeGetter[R],
+	condition where.ComparatorType,
+	value ...time.Time,
+) WhereOption[R] {
+	return WhereOption[R]{
+		Cmp:   comparators.NewTimeFieldComparator(condition, getter, value...),
+		Error: nil,
+		slots: nil,
+	}
+}
+
+// FieldTimeBetween checks that time is in range from low to high, bounds defines inclusion of low and high.
+func FieldTimeBetween[R record.Record](
+	getter record.TimeGetter[R],
+	low, high time.Time,
+	bounds where.Bounds,
+) WhereOption[R] {
+	cmp := comparators.NewTimeFieldComparator(where.Between, getter, low, high)
+	cmp.Bounds = bounds
+
+	return WhereOption[R]{
+		Cmp:   cmp,
+		Error: nil,
+		slots: nil,
+	}
+}
+
 func FieldStringRegexp[R record.Record](
 	getter record.ComparableGetter[R, string],
 	value *regexp.Regexp,
@@ -67,6 +164,36 @@ func FieldStringRegexp[R record.Record](
 	return WhereOption[R]{
 		Cmp:   comparators.NewStringFieldRegexpComparator[R](where.Regexp, getter, value),
 		Error: nil,
+		slots: nil,
+	}
+}
+
+// FieldFolded adds case- and accent-insensitive condition for string field, values are folded by fold.String.
+// Index of the field is used only if it's created for folded values, see hash.NewFoldedStringHashIndex.
+func FieldFolded[R record.Record](
+	getter record.ComparableGetter[R, string],
+	condition where.ComparatorType,
+	value ...string,
+) WhereOption[R] {
+	return WhereOption[R]{
+		Cmp:   comparators.NewFoldedStringFieldComparator(condition, getter, value...),
+		Error: nil,
+		slots: nil,
+	}
+}
+
+// FieldFuzzy checks that edit distance between value of string field and term is not greater than maxEdits,
+// distance.Damerau counts transposition of adjacent characters as a single edit.
+func FieldFuzzy[R record.Record](
+	getter record.GetterInterface[R, string],
+	term string,
+	maxEdits int,
+	metric distance.Metric,
+) WhereOption[R] {
+	return WhereOption[R]{
+		Cmp:   comparators.NewFuzzyComparator(where.Fuzzy, getter, term, maxEdits, metric),
+		Error: nil,
+		slots: nil,
 	}
 }
 
@@ -82,6 +209,7 @@ func FieldBool[R record.Record](
 			Value:  value,
 		},
 		Error: nil,
+		slots: nil,
 	}
 }
 
@@ -93,6 +221,7 @@ func FieldMap[R record.Record, K comparable, V any](
 	return WhereOption[R]{
 		Cmp:   comparators.NewMapFieldComparator[R, K, V](condition, getter, value...),
 		Error: nil,
+		slots: nil,
 	}
 }
 
@@ -104,5 +233,66 @@ func FieldSet[R record.Record, T comparable](
 	return WhereOption[R]{
 		Cmp:   comparators.NewSetFieldComparator[R, T](condition, getter, value...),
 		Error: nil,
+		slots: nil,
+	}
+}
+
+func FieldSlice[R record.Record, T comparable](
+	getter record.SliceGetter[R, T],
+	condition where.ComparatorType,
+	value ...T,
+) WhereOption[R] {
+	return WhereOption[R]{
+		Cmp:   comparators.NewSliceFieldComparator[R, T](condition, getter, value...),
+		Error: nil,
+		slots: nil,
+	}
+}
+
+// FieldSliceAny checks that any element of slice satisfies condition with values, as query.Field for element.
+func FieldSliceAny[R record.Record, T record.LessComparable](
+	getter record.SliceGetter[R, T],
+	condition where.ComparatorType,
+	value ...T,
+) WhereOption[R] {
+	option := Field(record.ComparableGetter[R, T]{Field: getter.Field, Get: nil}, condition, value...)
+	if option.Error != nil {
+		return option
+	}
+
+	return WhereOption[R]{
+		Cmp:   comparators.NewSliceAnyComparator(getter, option.Cmp.(comparators.ElementComparator[R, T])),
+		Error: nil,
+		slots: nil,
+	}
+}
+
+// FieldNullable adds condition for nullable field. Use where.IsNull and where.IsNotNull without values for check NULL.
+func FieldNullable[R record.Record, T record.LessComparable](
+	getter record.NullableGetter[R, T],
+	condition where.ComparatorType,
+	value ...T,
+) WhereOption[R] {
+	return WhereOption[R]{
+		Cmp:   comparators.NewNullableFieldComparator(condition, getter, value...),
+		Error: nil,
+		slots: nil,
+	}
+}
+
+// FieldNullableBetween checks that field isn't NULL and its value is in range from low to high,
+// bounds defines inclusion of low and high.
+func FieldNullableBetween[R record.Record, T record.LessComparable](
+	getter record.NullableGetter[R, T],
+	low, high T,
+	bounds where.Bounds,
+) WhereOption[R] {
+	cmp := comparators.NewNullableFieldComparator(where.Between, getter, low, high)
+	cmp.Bounds = bounds
+
+	return WhereOption[R]{
+		Cmp:   cmp,
+		Error: nil,
+		slots: nil,
 	}
 }
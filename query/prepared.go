@@ -0,0 +1,171 @@
+package query
+
+import (
+	"slices"
+
+	"github.com/shamcode/simd/record"
+	"github.com/shamcode/simd/where"
+)
+
+type (
+	// Binder is a named placeholder for value, which set before query execution.
+	Binder interface {
+		Name() string
+	}
+
+	// Parameter is a placeholder for value of type T.
+	// Parameter is linked to values of comparators by FieldParam, values are set by Prepared.Bind
+	// of the query only, so the same parameter can be used by many queries.
+	Parameter[T any] struct {
+		name string
+	}
+
+	// Slot is a value of comparator, which is set by the parameter.
+	Slot struct {
+		Param Binder
+
+		// convert converts value to the type of the parameter and returns assignment of the converted value
+		convert func(value any) (assign func(), err error)
+	}
+
+	// ParameterizedQuery is implemented by queries with conditions created by FieldParam.
+	ParameterizedQuery interface {
+		Slots() []Slot
+	}
+
+	// Prepared is a query with parameters, which validated once and executed many times with different values.
+	// Prepared isn't safe for concurrent use: binding changes values of comparators, which shared by all executions.
+	// Prepare a separate query for each goroutine.
+	Prepared[R record.Record] struct {
+		query Query[R]
+		slots map[string][]Slot
+	}
+)
+
+// Param creates a placeholder for value with name.
+func Param[T any](name string) *Parameter[T] {
+	return &Parameter[T]{
+		name: name,
+	}
+}
+
+func (p *Parameter[T]) Name() string {
+	return p.name
+}
+
+// FieldParam is like Field, but values are set later by parameters.
+func FieldParam[R record.Record, T record.LessComparable](
+	getter record.ComparableGetter[R, T],
+	condition where.ComparatorType,
+	params ...*Parameter[T],
+) WhereOption[R] {
+	values := make([]T, len(params))
+	slots := make([]Slot, len(params))
+
+	for i, param := range params {
+		slots[i] = Slot{
+			Param: param,
+			convert: func(value any) (func(), error) {
+				casted, err := Cast[any, T](value)
+				if err != nil {
+					return nil, ParameterError{Name: param.name, Err: err}
+				}
+
+				return func() { values[i] = casted }, nil
+			},
+		}
+	}
+
+	option := Field(getter, condition, values...)
+	option.slots = slots
+
+	return option
+}
+
+// SlotsOf returns slots of FieldParam conditions of the query.
+func SlotsOf[R record.Record](q Query[R]) []Slot {
+	if parameterized, ok := q.(ParameterizedQuery); ok {
+		return parameterized.Slots()
+	}
+
+	return nil
+}
+
+// Prepare validates query once and links it with parameters.
+// All parameters must be used in query conditions and all parameters of query conditions must be passed.
+func Prepare[R record.Record](q Query[R], params ...Binder) (*Prepared[R], error) {
+	if err := q.Error(); err != nil {
+		return nil, err
+	}
+
+	byName := make(map[string][]Slot, len(params))
+
+	for _, param := range params {
+		if _, exists := byName[param.Name()]; exists {
+			return nil, ParameterError{Name: param.Name(), Err: ErrDuplicateParameter}
+		}
+
+		byName[param.Name()] = nil
+	}
+
+	for _, slot := range SlotsOf(q) {
+		name := slot.Param.Name()
+
+		if _, ok := byName[name]; !ok || !slices.Contains(params, slot.Param) {
+			return nil, ParameterError{Name: name, Err: ErrParameterNotPrepared}
+		}
+
+		byName[name] = append(byName[name], slot)
+	}
+
+	for _, param := range params {
+		if len(byName[param.Name()]) == 0 {
+			return nil, ParameterError{Name: param.Name(), Err: ErrUnusedParameter}
+		}
+	}
+
+	return &Prepared[R]{
+		query: q,
+		slots: byName,
+	}, nil
+}
+
+// Bind sets values of all parameters by name and returns query for execution.
+// Values are set only if all of them are valid, so the query keeps values of the previous Bind on error.
+// Returned query is the same for all calls, so it must be executed before the next Bind.
+func (p *Prepared[R]) Bind(values map[string]any) (Query[R], error) {
+	for name := range values {
+		if _, ok := p.slots[name]; !ok {
+			return nil, ParameterError{Name: name, Err: ErrUnknownParameter}
+		}
+	}
+
+	var assigns []func()
+
+	for name, slots := range p.slots {
+		value, ok := values[name]
+		if !ok {
+			return nil, ParameterError{Name: name, Err: ErrParameterNotBound}
+		}
+
+		for _, slot := range slots {
+			assign, err := slot.convert(value)
+			if err != nil {
+				return nil, err
+			}
+
+			assigns = append(assigns, assign)
+		}
+	}
+
+	for _, assign := range assigns {
+		assign()
+	}
+
+	return p.query, nil
+}
+
+// Query returns query with values of the last Bind.
+func (p *Prepared[R]) Query() Query[R] {
+	return p.query
+}
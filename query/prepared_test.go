@@ -0,0 +1,129 @@
+package query
+
+import (
+	"errors"
+	"testing"
+
+	asserts "github.com/shamcode/assert"
+	"github.com/shamcode/simd/record"
+	"github.com/shamcode/simd/where"
+)
+
+type item struct {
+	id   int64
+	name string
+}
+
+func (i *item) GetID() int64 { return i.id }
+
+func TestPrepared(t *testing.T) {
+	id := record.NewIDGetter[*item]()
+	name := record.ComparableGetter[*item, string]{
+		Field: record.NewFields().New("name"),
+		Get:   func(item *item) string { return item.name },
+	}
+
+	minID := Param[int64]("minID")
+	names := [2]*Parameter[string]{Param[string]("first"), Param[string]("second")}
+
+	prepared, err := Prepare(
+		NewBuilder[*item]().
+			Where(FieldParam(id, where.GE, minID)).
+			Where(FieldParam(name, where.InArray, names[0], names[1])).
+			Query(),
+		minID, names[0], names[1],
+	)
+	asserts.Success(t, err)
+
+	check := func(q Query[*item], expected []int64) {
+		t.Helper()
+
+		var ids []int64
+
+		for _, it := range []*item{{id: 1, name: "foo"}, {id: 2, name: "bar"}, {id: 3, name: "foo"}} {
+			ok, err := q.Conditions().Check(it)
+			asserts.Success(t, err)
+
+			if ok {
+				ids = append(ids, it.id)
+			}
+		}
+
+		asserts.Equals(t, expected, ids, "ids")
+	}
+
+	q, err := prepared.Bind(map[string]any{"minID": int64(2), "first": "foo", "second": "baz"})
+	asserts.Success(t, err)
+	check(q, []int64{3})
+
+	q, err = prepared.Bind(map[string]any{"minID": int64(1), "first": "bar", "second": "foo"})
+	asserts.Success(t, err)
+	check(q, []int64{1, 2, 3})
+
+	t.Run("reused parameter", func(t *testing.T) {
+		other, err := Prepare(NewBuilder[*item]().Where(FieldParam(id, where.LT, minID)).Query(), minID)
+		asserts.Success(t, err)
+
+		q, err := other.Bind(map[string]any{"minID": int64(3)})
+		asserts.Success(t, err)
+		check(q, []int64{1, 2})
+
+		// Binding of the other query doesn't change values of the prepared query
+		check(prepared.Query(), []int64{1, 2, 3})
+	})
+
+	t.Run("bind errors", func(t *testing.T) {
+		testCases := []struct {
+			values        map[string]any
+			expectedError string
+		}{
+			{
+				values:        map[string]any{"minID": int64(1), "first": "foo"},
+				expectedError: "parameter second: parameter value not set",
+			},
+			{
+				values:        map[string]any{"minID": 1, "first": "foo", "second": "bar"},
+				expectedError: "parameter minID: cannot cast int to int64",
+			},
+			{
+				values:        map[string]any{"minID": int64(3), "first": 1, "second": "baz"},
+				expectedError: "parameter first: cannot cast int to string",
+			},
+			{
+				values:        map[string]any{"maxID": int64(1)},
+				expectedError: "parameter maxID: unknown parameter",
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.expectedError, func(t *testing.T) {
+				_, err := prepared.Bind(testCase.values)
+				asserts.Equals(t, testCase.expectedError, err.Error(), "error")
+
+				// Values of the previous Bind are kept
+				check(prepared.Query(), []int64{1, 2, 3})
+			})
+		}
+	})
+
+	t.Run("prepare errors", func(t *testing.T) {
+		_, err := Prepare(NewBuilder[*item]().Where(FieldParam(id, where.EQ, minID)).Query(), minID, Param[int]("unused"))
+		asserts.Equals(t, true, errors.Is(err, ErrUnusedParameter), "unused")
+
+		_, err = Prepare(NewBuilder[*item]().Where(FieldParam(id, where.EQ, minID)).Query(), minID, minID)
+		asserts.Equals(t, true, errors.Is(err, ErrDuplicateParameter), "duplicate")
+
+		_, err = Prepare(
+			NewBuilder[*item]().
+				Where(FieldParam(id, where.GE, minID)).
+				Where(FieldParam(name, where.EQ, names[0])).
+				Query(),
+			minID,
+		)
+		asserts.Equals(t, true, errors.Is(err, ErrParameterNotPrepared), "not prepared")
+		asserts.Equals(t, "parameter first: parameter of conditions isn't passed to Prepare", err.Error(), "error")
+
+		_, err = Prepare(NewBuilder[*item]().Or().Where(FieldParam(id, where.EQ, minID)).Query(), minID)
+		asserts.Equals(t, true, errors.Is(err, ErrOrBeforeAnyConditions), "builder error")
+	})
+}
@@ -20,6 +20,7 @@ type query[R record.Record] struct {
 	limit               int
 	withLimit           bool
 	conditions          where.Conditions[R]
+	slots               []Slot
 	sorting             []sort.ByWithOrder[R]
 	onIterationCallback *func(item R)
 	error               error
@@ -29,6 +30,10 @@ func (q query[R]) Conditions() where.Conditions[R] {
 	return q.conditions
 }
 
+func (q query[R]) Slots() []Slot {
+	return q.slots
+}
+
 func (q query[R]) Sorting() []sort.ByWithOrder[R] {
 	return q.sorting
 }
@@ -0,0 +1,163 @@
+package query
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/shamcode/simd/record"
+	"github.com/shamcode/simd/sort"
+)
+
+// SetOperation is an operation for combining results of queries.
+type SetOperation uint8
+
+const (
+	// UnionOperation selects records satisfied any of queries.
+	UnionOperation SetOperation = iota + 1
+
+	// IntersectOperation selects records satisfied all queries.
+	IntersectOperation
+
+	// ExceptOperation selects records satisfied the first query and not satisfied other queries.
+	ExceptOperation
+)
+
+func (op SetOperation) String() string {
+	switch op {
+	case UnionOperation:
+		return "UNION"
+	case IntersectOperation:
+		return "INTERSECT"
+	case ExceptOperation:
+		return "EXCEPT"
+	default:
+		return "<unknown>"
+	}
+}
+
+// SetQuery combines results of queries on the same namespace. Each query is executed as separate query
+// with own conditions, sorting, offset, limit and OnIteration callback, sorting, offset and limit
+// of SetQuery are applied to the combined result. SetQuery can be used as query of other SetQuery.
+type SetQuery[R record.Record] interface {
+	Query[R]
+	Operation() SetOperation
+	Queries() []Query[R]
+}
+
+// SetBuilder builds SetQuery.
+type SetBuilder[R record.Record] interface {
+	Sort(by sort.ByWithOrder[R]) SetBuilder[R]
+	Limit(limitItems int) SetBuilder[R]
+	Offset(startOffset int) SetBuilder[R]
+
+	// OnIteration registers a callback to be called for each record of the combined result before sorting
+	// and applying offset/limits
+	OnIteration(cb func(item R)) SetBuilder[R]
+
+	// Query return build SetQuery
+	Query() SetQuery[R]
+}
+
+type setQuery[R record.Record] struct {
+	query[R]
+	operation SetOperation
+	queries   []Query[R]
+}
+
+func (q setQuery[R]) Operation() SetOperation {
+	return q.operation
+}
+
+func (q setQuery[R]) Queries() []Query[R] {
+	return q.queries
+}
+
+// Slots returns slots of all queries.
+func (q setQuery[R]) Slots() []Slot {
+	var slots []Slot
+	for _, item := range q.queries {
+		slots = append(slots, SlotsOf(item)...)
+	}
+
+	return slots
+}
+
+type setBuilder[R record.Record] struct {
+	query setQuery[R]
+}
+
+func (sb *setBuilder[R]) Sort(by sort.ByWithOrder[R]) SetBuilder[R] {
+	sb.query.sorting = append(sb.query.sorting, by)
+
+	return sb
+}
+
+func (sb *setBuilder[R]) Limit(limitItems int) SetBuilder[R] {
+	sb.query.limit = limitItems
+	sb.query.withLimit = true
+
+	return sb
+}
+
+func (sb *setBuilder[R]) Offset(startOffset int) SetBuilder[R] {
+	sb.query.offset = startOffset
+
+	return sb
+}
+
+func (sb *setBuilder[R]) OnIteration(fn func(item R)) SetBuilder[R] {
+	sb.query.onIterationCallback = &fn
+
+	return sb
+}
+
+func (sb *setBuilder[R]) Query() SetQuery[R] {
+	q := sb.query
+	q.sorting = slices.Clone(sb.query.sorting)
+
+	errs := make([]error, 0, len(q.queries)+1)
+	if len(q.queries) < 2 { //nolint:mnd
+		errs = append(errs, ErrNotEnoughQueries)
+	}
+
+	for _, item := range q.queries {
+		errs = append(errs, item.Error())
+	}
+
+	q.error = errors.Join(errs...)
+
+	return q
+}
+
+func newSetBuilder[R record.Record](operation SetOperation, queries []Query[R]) SetBuilder[R] {
+	return &setBuilder[R]{
+		query: setQuery[R]{
+			query: query[R]{
+				offset:              0,
+				limit:               0,
+				withLimit:           false,
+				conditions:          nil,
+				sorting:             nil,
+				onIterationCallback: nil,
+				error:               nil,
+			},
+			operation: operation,
+			queries:   slices.Clone(queries),
+		},
+	}
+}
+
+// Union combines queries into query selected records satisfied any of queries.
+func Union[R record.Record](queries ...Query[R]) SetBuilder[R] {
+	return newSetBuilder(UnionOperation, queries)
+}
+
+// Intersect combines queries into query selected records satisfied all queries.
+func Intersect[R record.Record](queries ...Query[R]) SetBuilder[R] {
+	return newSetBuilder(IntersectOperation, queries)
+}
+
+// Except combines queries into query selected records satisfied the first query and not satisfied other queries.
+func Except[R record.Record](queries ...Query[R]) SetBuilder[R] {
+	return newSetBuilder(ExceptOperation, queries)
+}
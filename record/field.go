@@ -1,22 +1,47 @@
 package record
 
+import (
+	"errors"
+	"math"
+)
+
+// ErrTooManyFields is a panic value of FieldsConstructor.New, when all indexes of fields are used.
+var ErrTooManyFields = errors.New("too many fields: all indexes of fields are used")
+
+const (
+	// IDFieldIndex is an index of ID field.
+	IDFieldIndex uint16 = 0
+
+	// ComputedFieldIndex is an index of fields computed from the whole record, like predicates and expressions.
+	// The index isn't used by FieldsConstructor.
+	ComputedFieldIndex uint16 = math.MaxUint16
+
+	// MaxFieldIndex is a max index of field created by FieldsConstructor.
+	MaxFieldIndex = ComputedFieldIndex - 1
+)
+
 type Field interface {
-	Index() uint8
+	Index() uint16
 	String() string
 }
 
 type field struct {
-	index uint8
+	index uint16
 	name  string
 }
 
-func (f field) Index() uint8   { return f.index }
+func (f field) Index() uint16  { return f.index }
 func (f field) String() string { return f.name }
 
-type FieldsConstructor uint8
+type FieldsConstructor uint16
 
+// New creates field with next index. New panics with ErrTooManyFields, if index is greater than MaxFieldIndex.
 func (fc *FieldsConstructor) New(name string) Field {
-	index := uint8(*fc)
+	if *fc > FieldsConstructor(MaxFieldIndex) {
+		panic(ErrTooManyFields)
+	}
+
+	index := uint16(*fc)
 	*fc++
 
 	return field{
@@ -26,6 +51,6 @@ func (fc *FieldsConstructor) New(name string) Field {
 }
 
 func NewFields() *FieldsConstructor {
-	var fc = FieldsConstructor(1) // starts with 1, because 0 reserved for ID field
+	var fc = FieldsConstructor(IDFieldIndex + 1) // starts with 1, because 0 reserved for ID field
 	return &fc
 }
@@ -12,7 +12,7 @@ func TestField(t *testing.T) {
 	testCases := []struct {
 		field         Field
 		expectedName  string
-		expectedIndex uint8
+		expectedIndex uint16
 	}{
 		{
 			field:         NewIDGetter[Record](),
@@ -36,3 +36,17 @@ func TestField(t *testing.T) {
 		asserts.Equals(t, testCase.expectedIndex, testCase.field.Index(), "index")
 	}
 }
+
+func TestTooManyFields(t *testing.T) {
+	fields := FieldsConstructor(MaxFieldIndex - 1)
+
+	asserts.Equals(t, MaxFieldIndex-1, fields.New("first").Index(), "index")
+	asserts.Equals(t, MaxFieldIndex, fields.New("last").Index(), "max index")
+
+	defer func() {
+		asserts.Equals[any](t, ErrTooManyFields, recover(), "panic")
+	}()
+
+	fields.New("overflow")
+	t.Fatal("overflow isn't detected")
+}
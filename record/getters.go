@@ -1,5 +1,7 @@
 package record
 
+import "time"
+
 type LessComparable interface {
 	~int | ~int8 | ~int16 | ~int32 | ~int64 |
 		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
@@ -22,6 +24,15 @@ type (
 	ComparableGetter[R Record, T LessComparable] Getter[R, T]
 	MapGetter[R Record, K comparable, V any]     Getter[R, Map[K, V]]
 	SetGetter[R Record, T comparable]            Getter[R, Set[T]]
+	SliceGetter[R Record, T comparable]          Getter[R, []T]
+	TimeGetter[R Record]                         Getter[R, time.Time]
+
+	// NullableGetter is a getter for optional field, Get returns false for NULL.
+	NullableGetter[R Record, T LessComparable] struct {
+		Field
+
+		Get func(item R) (T, bool)
+	}
 )
 
 func (getter Getter[R, T]) GetForRecord(item R) T               { return getter.Get(item) }
@@ -29,6 +40,51 @@ func (getter BoolGetter[R]) GetForRecord(item R) bool           { return getter.
 func (getter ComparableGetter[R, T]) GetForRecord(item R) T     { return getter.Get(item) }
 func (getter MapGetter[R, K, V]) GetForRecord(item R) Map[K, V] { return getter.Get(item) }
 func (getter SetGetter[R, T]) GetForRecord(item R) Set[T]       { return getter.Get(item) }
+func (getter SliceGetter[R, T]) GetForRecord(item R) []T        { return getter.Get(item) }
+func (getter TimeGetter[R]) GetForRecord(item R) time.Time      { return getter.Get(item) }
+
+// GetForRecord returns zero value for NULL.
+func (getter NullableGetter[R, T]) GetForRecord(item R) T {
+	value, _ := getter.Get(item)
+	return value
+}
+
+// SetSize creates getter of set size for size comparisons, field must be created for the getter.
+func SetSize[R Record, T comparable](field Field, getter SetGetter[R, T]) ComparableGetter[R, int] {
+	return ComparableGetter[R, int]{
+		Field: field,
+		Get: func(item R) int {
+			if set := getter.Get(item); nil != set {
+				return set.Len()
+			}
+
+			return 0
+		},
+	}
+}
+
+// SliceLen creates getter of slice length for length comparisons, field must be created for the getter.
+func SliceLen[R Record, T comparable](field Field, getter SliceGetter[R, T]) ComparableGetter[R, int] {
+	return ComparableGetter[R, int]{
+		Field: field,
+		Get: func(item R) int {
+			return len(getter.Get(item))
+		},
+	}
+}
 
 func (getter BoolGetter[R]) Less(a, b R) bool          { return !getter.Get(a) && getter.Get(b) }
 func (getter ComparableGetter[R, T]) Less(a, b R) bool { return getter.Get(a) < getter.Get(b) }
+func (getter TimeGetter[R]) Less(a, b R) bool          { return getter.Get(a).Before(getter.Get(b)) }
+
+// Less places NULL before any value.
+func (getter NullableGetter[R, T]) Less(a, b R) bool {
+	aValue, aValid := getter.Get(a)
+	bValue, bValid := getter.Get(b)
+
+	if !aValid || !bValid {
+		return !aValid && bValid
+	}
+
+	return aValue < bValue
+}
@@ -3,6 +3,7 @@ package record
 import (
 	"sort"
 	"testing"
+	"time"
 
 	asserts "github.com/shamcode/assert"
 )
@@ -18,6 +19,7 @@ type (
 		enum16 enum16
 		int32  int32
 		string string
+		time   time.Time
 	}
 )
 
@@ -35,6 +37,7 @@ func TestGetters(t *testing.T) {
 			enum16: 20,
 			int32:  100,
 			string: "cccc",
+			time:   time.Date(2022, time.March, 1, 10, 0, 0, 0, time.UTC),
 		},
 		{
 			id:     2,
@@ -44,6 +47,7 @@ func TestGetters(t *testing.T) {
 			enum16: 10,
 			int32:  150,
 			string: "aaaa",
+			time:   time.Date(2022, time.March, 1, 12, 0, 0, 0, time.FixedZone("+03:00", 3*60*60)),
 		},
 		{
 			id:     3,
@@ -53,6 +57,7 @@ func TestGetters(t *testing.T) {
 			enum16: 15,
 			int32:  120,
 			string: "bbbb",
+			time:   time.Date(2022, time.February, 1, 10, 0, 0, 0, time.UTC),
 		},
 	}
 
@@ -96,6 +101,13 @@ func TestGetters(t *testing.T) {
 				},
 				expectedOrder: []int64{2, 3, 1},
 			},
+			{
+				getter: TimeGetter[user]{
+					Field: fields.New("time"),
+					Get:   func(item user) time.Time { return item.time },
+				},
+				expectedOrder: []int64{3, 2, 1},
+			},
 		}
 
 		for _, tc := range testCases {
@@ -113,4 +125,49 @@ func TestGetters(t *testing.T) {
 			})
 		}
 	})
+	t.Run("truncate", func(t *testing.T) {
+		india := time.FixedZone("+05:30", 5*60*60+30*60)
+		value := time.Date(2022, time.March, 1, 23, 45, 30, 100, time.UTC)
+
+		testCases := []struct {
+			name     string
+			actual   time.Time
+			expected time.Time
+		}{
+			{
+				name:     "hour UTC",
+				actual:   StartOfHour(value, time.UTC),
+				expected: time.Date(2022, time.March, 1, 23, 0, 0, 0, time.UTC),
+			},
+			{
+				name:     "hour +05:30",
+				actual:   StartOfHour(value, india),
+				expected: time.Date(2022, time.March, 2, 5, 0, 0, 0, india),
+			},
+			{
+				name:     "day UTC",
+				actual:   StartOfDay(value, time.UTC),
+				expected: time.Date(2022, time.March, 1, 0, 0, 0, 0, time.UTC),
+			},
+			{
+				name:     "day +05:30",
+				actual:   StartOfDay(value, india),
+				expected: time.Date(2022, time.March, 2, 0, 0, 0, 0, india),
+			},
+			{
+				name: "getter",
+				actual: TimeByDay(fields.New("day"), TimeGetter[user]{
+					Field: fields.New("created_at"),
+					Get:   func(user) time.Time { return value },
+				}, india).GetForRecord(user{}),
+				expected: time.Date(2022, time.March, 2, 0, 0, 0, 0, india),
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				asserts.Equals(t, tc.expected.String(), tc.actual.String(), "time")
+			})
+		}
+	})
 }
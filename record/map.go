@@ -1,5 +1,10 @@
 package record
 
+import (
+	"iter"
+	"maps"
+)
+
 type MapValueComparator[V any] interface {
 	Compare(value V) (bool, error)
 }
@@ -8,3 +13,38 @@ type Map[K comparable, V any] interface {
 	HasKey(key K) bool
 	HasValue(check MapValueComparator[V]) (bool, error)
 }
+
+// MapEntries is an optional interface of Map with access to values by key.
+// MapKeyValueEQ condition and map indexes require Map implementing MapEntries.
+type MapEntries[K comparable, V any] interface {
+	Get(key K) (V, bool)
+	All() iter.Seq2[K, V]
+}
+
+// MapOf is a Map implementation based on map.
+type MapOf[K comparable, V any] map[K]V
+
+func (m MapOf[K, V]) HasKey(key K) bool {
+	_, ok := m[key]
+	return ok
+}
+
+func (m MapOf[K, V]) HasValue(check MapValueComparator[V]) (bool, error) {
+	for _, value := range m {
+		res, err := check.Compare(value)
+		if err != nil || res {
+			return res, err
+		}
+	}
+
+	return false, nil
+}
+
+func (m MapOf[K, V]) Get(key K) (V, bool) {
+	value, ok := m[key]
+	return value, ok
+}
+
+func (m MapOf[K, V]) All() iter.Seq2[K, V] {
+	return maps.All(m)
+}
@@ -8,7 +8,7 @@ type Record interface {
 func NewIDGetter[R Record]() ComparableGetter[R, int64] {
 	return ComparableGetter[R, int64]{
 		Field: field{
-			index: 0,
+			index: IDFieldIndex,
 			name:  "ID",
 		},
 		Get: R.GetID,
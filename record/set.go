@@ -1,5 +1,38 @@
 package record
 
+import (
+	"iter"
+	"maps"
+)
+
 type Set[T comparable] interface {
 	Has(item T) bool
+	Len() int
+	All() iter.Seq[T]
+}
+
+// MapSet is a Set implementation based on map.
+type MapSet[T comparable] map[T]struct{}
+
+func (s MapSet[T]) Has(item T) bool {
+	_, ok := s[item]
+	return ok
+}
+
+func (s MapSet[T]) Len() int {
+	return len(s)
+}
+
+func (s MapSet[T]) All() iter.Seq[T] {
+	return maps.Keys(s)
+}
+
+// NewMapSet creates MapSet with items.
+func NewMapSet[T comparable](items ...T) MapSet[T] {
+	set := make(MapSet[T], len(items))
+	for _, item := range items {
+		set[item] = struct{}{}
+	}
+
+	return set
 }
@@ -0,0 +1,42 @@
+package record
+
+import "time"
+
+// StartOfHour returns start of the hour of t in the location. Unlike time.Time.Truncate, the offset of
+// the location is respected, so hours of locations with offset like +05:30 are truncated correctly.
+func StartOfHour(t time.Time, loc *time.Location) time.Time {
+	t = t.In(loc)
+
+	return t.Add(-time.Duration(t.Minute())*time.Minute -
+		time.Duration(t.Second())*time.Second -
+		time.Duration(t.Nanosecond()))
+}
+
+// StartOfDay returns midnight of the day of t in the location.
+func StartOfDay(t time.Time, loc *time.Location) time.Time {
+	year, month, day := t.In(loc).Date()
+
+	return time.Date(year, month, day, 0, 0, 0, 0, loc)
+}
+
+// TimeByHour creates getter of start of the hour of time in the location for comparing by hours,
+// field must be created for the getter.
+func TimeByHour[R Record](field Field, getter TimeGetter[R], loc *time.Location) TimeGetter[R] {
+	return TimeGetter[R]{
+		Field: field,
+		Get: func(item R) time.Time {
+			return StartOfHour(getter.Get(item), loc)
+		},
+	}
+}
+
+// TimeByDay creates getter of midnight of the day of time in the location for comparing by days,
+// field must be created for the getter.
+func TimeByDay[R Record](field Field, getter TimeGetter[R], loc *time.Location) TimeGetter[R] {
+	return TimeGetter[R]{
+		Field: field,
+		Get: func(item R) time.Time {
+			return StartOfDay(getter.Get(item), loc)
+		},
+	}
+}
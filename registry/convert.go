@@ -0,0 +1,179 @@
+//nolint:exhaustive
+package registry
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"time"
+)
+
+var timeType = reflect.TypeFor[time.Time]()
+
+// Convert converts a value received from outside (query language, JSON, etc.) to the field type.
+// Numbers are converted between integer and float kinds only without loss of precision,
+// values of named types (enums) are accepted if the underlying kind matches,
+// strings are converted to time.Time in RFC 3339 format.
+func Convert[T any](value any) (T, error) {
+	if res, ok := value.(T); ok {
+		return res, nil
+	}
+
+	var res T
+
+	target := reflect.ValueOf(&res).Elem()
+	source := reflect.ValueOf(value)
+
+	if !source.IsValid() || !convert(source, target) {
+		return res, NewConvertValueError(value, target.Type())
+	}
+
+	return res, nil
+}
+
+// ConvertAll converts all values to the field type.
+// ConvertValueError.Index contains index of value, which can't be converted.
+func ConvertAll[T any](values []any) ([]T, error) {
+	res := make([]T, len(values))
+
+	for i, value := range values {
+		converted, err := Convert[T](value)
+		if err != nil {
+			var convertErr ConvertValueError
+			if errors.As(err, &convertErr) {
+				convertErr.Index = i
+				return nil, convertErr
+			}
+
+			return nil, err
+		}
+
+		res[i] = converted
+	}
+
+	return res, nil
+}
+
+func convert(source, target reflect.Value) bool { //nolint:cyclop
+	if target.Type() == timeType {
+		return toTime(source, target)
+	}
+
+	switch target.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		value, ok := toInt(source)
+		if !ok || target.OverflowInt(value) {
+			return false
+		}
+
+		target.SetInt(value)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		value, ok := toUint(source)
+		if !ok || target.OverflowUint(value) {
+			return false
+		}
+
+		target.SetUint(value)
+	case reflect.Float32, reflect.Float64:
+		value, ok := toFloat(source)
+		if !ok || target.OverflowFloat(value) {
+			return false
+		}
+
+		target.SetFloat(value)
+	case reflect.String:
+		if source.Kind() != reflect.String {
+			return false
+		}
+
+		target.SetString(source.String())
+	case reflect.Bool:
+		if source.Kind() != reflect.Bool {
+			return false
+		}
+
+		target.SetBool(source.Bool())
+	default:
+		if !source.Type().ConvertibleTo(target.Type()) || source.Kind() != target.Kind() {
+			return false
+		}
+
+		target.Set(source.Convert(target.Type()))
+	}
+
+	return true
+}
+
+func toTime(source, target reflect.Value) bool {
+	if source.Kind() != reflect.String {
+		return false
+	}
+
+	value, err := time.Parse(time.RFC3339Nano, source.String())
+	if err != nil {
+		return false
+	}
+
+	target.Set(reflect.ValueOf(value))
+
+	return true
+}
+
+func toInt(source reflect.Value) (int64, bool) {
+	switch source.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return source.Int(), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		value := source.Uint()
+		if value > math.MaxInt64 {
+			return 0, false
+		}
+
+		return int64(value), true
+	case reflect.Float32, reflect.Float64:
+		value := source.Float()
+		if value != math.Trunc(value) || value < math.MinInt64 || value >= math.MaxInt64 {
+			return 0, false
+		}
+
+		return int64(value), true
+	default:
+		return 0, false
+	}
+}
+
+func toUint(source reflect.Value) (uint64, bool) {
+	switch source.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return source.Uint(), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		value := source.Int()
+		if value < 0 {
+			return 0, false
+		}
+
+		return uint64(value), true
+	case reflect.Float32, reflect.Float64:
+		value := source.Float()
+		if value != math.Trunc(value) || value < 0 || value >= math.MaxUint64 {
+			return 0, false
+		}
+
+		return uint64(value), true
+	default:
+		return 0, false
+	}
+}
+
+func toFloat(source reflect.Value) (float64, bool) {
+	switch source.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return source.Float(), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(source.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(source.Uint()), true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,130 @@
+package registry
+
+import (
+	"testing"
+	"time"
+
+	asserts "github.com/shamcode/assert"
+)
+
+type enum uint8
+
+func TestConvert(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		testCases := []struct {
+			name     string
+			convert  func() (any, error)
+			expected any
+		}{
+			{
+				name:     "int64 to int",
+				convert:  func() (any, error) { return Convert[int](int64(10)) },
+				expected: 10,
+			},
+			{
+				name:     "float64 to int8",
+				convert:  func() (any, error) { return Convert[int8](float64(-3)) },
+				expected: int8(-3),
+			},
+			{
+				name:     "int64 to enum",
+				convert:  func() (any, error) { return Convert[enum](int64(2)) },
+				expected: enum(2),
+			},
+			{
+				name:     "int64 to float32",
+				convert:  func() (any, error) { return Convert[float32](int64(2)) },
+				expected: float32(2),
+			},
+			{
+				name:     "string",
+				convert:  func() (any, error) { return Convert[string]("foo") },
+				expected: "foo",
+			},
+			{
+				name:     "bool",
+				convert:  func() (any, error) { return Convert[bool](true) },
+				expected: true,
+			},
+			{
+				name: "string to time",
+				convert: func() (any, error) {
+					value, err := Convert[time.Time]("2022-03-01T10:00:00.5+03:00")
+					return value.UTC(), err
+				},
+				expected: time.Date(2022, time.March, 1, 7, 0, 0, 500_000_000, time.UTC),
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				res, err := testCase.convert()
+				asserts.Success(t, err)
+				asserts.Equals(t, testCase.expected, res, "converted")
+			})
+		}
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		testCases := []struct {
+			name          string
+			convert       func() error
+			expectedError string
+		}{
+			{
+				name: "overflow",
+				convert: func() error {
+					_, err := Convert[uint8](int64(256))
+					return err
+				},
+				expectedError: "cannot use 256 (int64) as uint8",
+			},
+			{
+				name: "negative to unsigned",
+				convert: func() error {
+					_, err := Convert[uint](int64(-1))
+					return err
+				},
+				expectedError: "cannot use -1 (int64) as uint",
+			},
+			{
+				name: "fractional to int",
+				convert: func() error {
+					_, err := Convert[int](1.5)
+					return err
+				},
+				expectedError: "cannot use 1.5 (float64) as int",
+			},
+			{
+				name: "string to int",
+				convert: func() error {
+					_, err := Convert[int]("1")
+					return err
+				},
+				expectedError: "cannot use \"1\" (string) as int",
+			},
+			{
+				name: "invalid time",
+				convert: func() error {
+					_, err := Convert[time.Time]("2022-03-01")
+					return err
+				},
+				expectedError: "cannot use \"2022-03-01\" (string) as time.Time",
+			},
+			{
+				name: "nil",
+				convert: func() error {
+					_, err := Convert[int](nil)
+					return err
+				},
+				expectedError: "cannot use <nil> (<nil>) as int",
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				asserts.Equals(t, testCase.expectedError, testCase.convert().Error(), "error")
+			})
+		}
+	})
+}
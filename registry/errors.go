@@ -0,0 +1,88 @@
+package registry
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/shamcode/simd/where"
+)
+
+var (
+	ErrInvalidValuesCount = errors.New("invalid values count")
+	ErrIncompatibleField  = errors.New("incompatible field")
+)
+
+type (
+	FieldNotFoundError struct {
+		Name string
+	}
+	DuplicateFieldError struct {
+		Name string
+	}
+	UnsupportedComparatorError struct {
+		Cmp where.ComparatorType
+	}
+	ConvertValueError struct {
+		Index    int
+		Value    any
+		Expected reflect.Type
+	}
+)
+
+func (e FieldNotFoundError) Error() string {
+	return "field not found: " + e.Name
+}
+
+func (e FieldNotFoundError) Is(err error) bool {
+	_, ok := err.(FieldNotFoundError)
+	return ok
+}
+
+func NewFieldNotFoundError(name string) error {
+	return FieldNotFoundError{Name: name}
+}
+
+func (e DuplicateFieldError) Error() string {
+	return "field already registered: " + e.Name
+}
+
+func (e DuplicateFieldError) Is(err error) bool {
+	_, ok := err.(DuplicateFieldError)
+	return ok
+}
+
+func NewDuplicateFieldError(name string) error {
+	return DuplicateFieldError{Name: name}
+}
+
+func (e UnsupportedComparatorError) Error() string {
+	return "unsupported type of condition: " + strconv.Itoa(int(e.Cmp))
+}
+
+func (e UnsupportedComparatorError) Is(err error) bool {
+	_, ok := err.(UnsupportedComparatorError)
+	return ok
+}
+
+func NewUnsupportedComparatorError(cmp where.ComparatorType) error {
+	return UnsupportedComparatorError{Cmp: cmp}
+}
+
+func (e ConvertValueError) Error() string {
+	return fmt.Sprintf("cannot use %#v (%T) as %s", e.Value, e.Value, e.Expected)
+}
+
+func (e ConvertValueError) Is(err error) bool {
+	_, ok := err.(ConvertValueError)
+	return ok
+}
+
+func NewConvertValueError(value any, expected reflect.Type) error {
+	return ConvertValueError{
+		Index:    0,
+		Value:    value,
+		Expected: expected,
+	}
+}
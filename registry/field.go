@@ -0,0 +1,566 @@
+package registry
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/shamcode/simd/distance"
+	"github.com/shamcode/simd/query"
+	"github.com/shamcode/simd/record"
+	"github.com/shamcode/simd/sort"
+	"github.com/shamcode/simd/where"
+)
+
+var regexpType = reflect.TypeFor[*regexp.Regexp]()
+
+// Field describes a record field available for lookup by name.
+type Field[R record.Record] interface {
+	record.Field
+
+	// Where creates condition for the field, values are converted to the field type.
+	// Comparable fields accept other field of the same type as single value for comparing two fields.
+	// where.Between accepts optional where.Bounds after low and high values, bounds are inclusive by default.
+	Where(cmp where.ComparatorType, values ...any) query.WhereOption[R]
+
+	// Sort returns sorting by the field, or nil if the field can't be sorted.
+	Sort() sort.By[R]
+
+	// Type returns Go type of the field value, type of value for nullable fields.
+	Type() reflect.Type
+
+	// Getter returns getter of the field, for example record.ComparableGetter[R, T].
+	Getter() any
+
+	// Comparators returns types of conditions supported by Where.
+	Comparators() []where.ComparatorType
+}
+
+var (
+	equalityComparators = []where.ComparatorType{where.EQ, where.NE, where.InArray, where.NotInArray}
+	orderedComparators  = append(
+		slices.Clip(equalityComparators),
+		where.GT, where.GE, where.LT, where.LE, where.Between,
+	)
+	stringComparators = append(
+		slices.Clip(orderedComparators),
+		where.Like, where.StartsWith, where.Regexp, where.Fuzzy,
+	)
+	nullableComparators = append(slices.Clip(orderedComparators), where.IsNull, where.IsNotNull)
+	setComparators      = []where.ComparatorType{where.SetHas, where.SetHasAny, where.SetHasAll}
+	sliceComparators    = []where.ComparatorType{where.SliceContains, where.SliceContainsAll, where.SliceAny}
+	mapComparators      = []where.ComparatorType{where.MapHasKey, where.MapHasValue, where.MapKeyValueEQ}
+)
+
+// Supports checks that the field supports type of condition.
+func Supports[R record.Record](field Field[R], cmp where.ComparatorType) bool {
+	return slices.Contains(field.Comparators(), cmp)
+}
+
+// ValidValuesCount checks count of values of built-in type of condition, count of values of other types isn't checked.
+func ValidValuesCount(cmp where.ComparatorType, count int) bool {
+	switch cmp { //nolint:exhaustive
+	case where.EQ, where.NE, where.GT, where.GE, where.LT, where.LE, where.Like, where.StartsWith, where.Regexp,
+		where.Match, where.SetHas, where.MapHasKey, where.MapHasValue, where.SliceContains:
+		return count == 1
+	case where.InArray, where.NotInArray, where.SetHasAny, where.SetHasAll, where.SliceContainsAll:
+		return count > 0
+	case where.SliceAny:
+		// Type of condition for element and its values
+		return count > 0
+	case where.Between, where.Fuzzy:
+		// Between accepts optional bounds, Fuzzy accepts optional metric
+		return count == 2 || count == 3
+	case where.MapKeyValueEQ:
+		return count == 2 //nolint:mnd
+	case where.IsNull, where.IsNotNull:
+		return count == 0
+	default:
+		return true
+	}
+}
+
+// Where creates condition for the field, if the field supports type of condition and count of values is valid.
+func Where[R record.Record](field Field[R], cmp where.ComparatorType, values ...any) query.WhereOption[R] {
+	if !Supports(field, cmp) {
+		return whereError[R](field, NewUnsupportedComparatorError(cmp))
+	}
+
+	if !ValidValuesCount(cmp, len(values)) {
+		return whereError[R](field, fmt.Errorf("%w: %d", ErrInvalidValuesCount, len(values)))
+	}
+
+	return field.Where(cmp, values...)
+}
+
+// rangeBounds splits values of where.Between condition into low and high values and optional bounds.
+func rangeBounds(cmp where.ComparatorType, values []any) ([]any, where.Bounds, error) {
+	if cmp != where.Between || len(values) != 3 { //nolint:mnd
+		return values, where.Inclusive, nil
+	}
+
+	bounds, err := Convert[where.Bounds](values[2])
+	if err != nil || bounds > where.Exclusive {
+		return nil, where.Inclusive, NewConvertValueError(values[2], reflect.TypeFor[where.Bounds]())
+	}
+
+	return values[:2], bounds, nil
+}
+
+func whereError[R record.Record](field record.Field, err error) query.WhereOption[R] {
+	return query.WhereOption[R]{
+		Cmp:   nil,
+		Error: query.GetterError{Field: field, Err: err},
+	}
+}
+
+type comparableField[R record.Record, T record.LessComparable] struct {
+	record.ComparableGetter[R, T]
+}
+
+func (f comparableField[R, T]) Where(cmp where.ComparatorType, values ...any) query.WhereOption[R] {
+	if len(values) == 1 {
+		if other, ok := values[0].(Field[R]); ok {
+			return f.whereField(cmp, other)
+		}
+	}
+
+	if getter, ok := any(f.ComparableGetter).(record.ComparableGetter[R, string]); ok {
+		switch cmp { //nolint:exhaustive
+		case where.Regexp:
+			return stringRegexpWhere(getter, values)
+		case where.Fuzzy:
+			return stringFuzzyWhere(getter, values)
+		}
+	}
+
+	values, bounds, err := rangeBounds(cmp, values)
+	if err != nil {
+		return whereError[R](f.Field, err)
+	}
+
+	converted, err := ConvertAll[T](values)
+	if err != nil {
+		return whereError[R](f.Field, err)
+	}
+
+	if bounds != where.Inclusive {
+		return query.FieldBetween(f.ComparableGetter, converted[0], converted[1], bounds)
+	}
+
+	return query.Field(f.ComparableGetter, cmp, converted...)
+}
+
+// whereField compares field with other field of the same type.
+func (f comparableField[R, T]) whereField(cmp where.ComparatorType, other Field[R]) query.WhereOption[R] {
+	otherField, ok := other.(comparableField[R, T])
+	if !ok {
+		return whereError[R](f.Field, fmt.Errorf("%w: %s", ErrIncompatibleField, other))
+	}
+
+	return query.FieldToField(f.ComparableGetter, cmp, otherField.ComparableGetter)
+}
+
+func (f comparableField[R, T]) Sort() sort.By[R] {
+	return f.ComparableGetter
+}
+
+func (f comparableField[R, T]) Type() reflect.Type {
+	return reflect.TypeFor[T]()
+}
+
+func (f comparableField[R, T]) Getter() any {
+	return f.ComparableGetter
+}
+
+func (f comparableField[R, T]) Comparators() []where.ComparatorType {
+	if _, ok := any(f.ComparableGetter).(record.ComparableGetter[R, string]); ok {
+		return stringComparators
+	}
+
+	return orderedComparators
+}
+
+func stringRegexpWhere[R record.Record](
+	getter record.ComparableGetter[R, string],
+	values []any,
+) query.WhereOption[R] {
+	if len(values) != 1 {
+		return whereError[R](getter.Field, NewConvertValueError(values, regexpType))
+	}
+
+	if re, ok := values[0].(*regexp.Regexp); ok {
+		return query.FieldStringRegexp(getter, re)
+	}
+
+	pattern, err := Convert[string](values[0])
+	if err != nil {
+		return whereError[R](getter.Field, err)
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return whereError[R](getter.Field, err)
+	}
+
+	return query.FieldStringRegexp(getter, re)
+}
+
+// stringFuzzyWhere creates fuzzy condition from term, max edits and optional metric, Damerau by default.
+func stringFuzzyWhere[R record.Record](
+	getter record.ComparableGetter[R, string],
+	values []any,
+) query.WhereOption[R] {
+	if len(values) != 2 && len(values) != 3 {
+		return whereError[R](getter.Field, fmt.Errorf("%w: %d", ErrInvalidValuesCount, len(values)))
+	}
+
+	term, err := Convert[string](values[0])
+	if err != nil {
+		return whereError[R](getter.Field, err)
+	}
+
+	maxEdits, err := Convert[int](values[1])
+	if err != nil {
+		return whereError[R](getter.Field, err)
+	}
+
+	metric := distance.Damerau
+
+	if len(values) == 3 { //nolint:mnd
+		switch value := values[2].(type) {
+		case distance.Metric:
+			metric = value
+		default:
+			name, err := Convert[string](value)
+			if err != nil {
+				return whereError[R](getter.Field, err)
+			}
+
+			if metric, err = distance.ParseMetric(name); err != nil {
+				return whereError[R](getter.Field, err)
+			}
+		}
+	}
+
+	return query.FieldFuzzy[R](getter, term, maxEdits, metric)
+}
+
+// Comparable creates field for getter with comparable type.
+func Comparable[R record.Record, T record.LessComparable](getter record.ComparableGetter[R, T]) Field[R] {
+	return comparableField[R, T]{ComparableGetter: getter}
+}
+
+type foldedField[R record.Record] struct {
+	record.ComparableGetter[R, string]
+}
+
+func (f foldedField[R]) Where(cmp where.ComparatorType, values ...any) query.WhereOption[R] {
+	switch cmp { //nolint:exhaustive
+	case where.Regexp:
+		return stringRegexpWhere(f.ComparableGetter, values)
+	case where.Fuzzy:
+		return stringFuzzyWhere(f.ComparableGetter, values)
+	}
+
+	converted, err := ConvertAll[string](values)
+	if err != nil {
+		return whereError[R](f.Field, err)
+	}
+
+	return query.FieldFolded(f.ComparableGetter, cmp, converted...)
+}
+
+func (f foldedField[R]) Sort() sort.By[R] {
+	return sort.ByFolded[R](f.ComparableGetter)
+}
+
+func (f foldedField[R]) Type() reflect.Type {
+	return reflect.TypeFor[string]()
+}
+
+func (f foldedField[R]) Getter() any {
+	return f.ComparableGetter
+}
+
+func (f foldedField[R]) Comparators() []where.ComparatorType {
+	return stringComparators
+}
+
+// Folded creates string field with case- and accent-insensitive conditions and sorting.
+// Regexp and fuzzy conditions are applied to value as is, use (?i) flag for case-insensitive regexp.
+func Folded[R record.Record](getter record.ComparableGetter[R, string]) Field[R] {
+	return foldedField[R]{ComparableGetter: getter}
+}
+
+type boolField[R record.Record] struct {
+	record.BoolGetter[R]
+}
+
+func (f boolField[R]) Where(cmp where.ComparatorType, values ...any) query.WhereOption[R] {
+	converted, err := ConvertAll[bool](values)
+	if err != nil {
+		return whereError[R](f.Field, err)
+	}
+
+	return query.FieldBool(f.BoolGetter, cmp, converted...)
+}
+
+func (f boolField[R]) Sort() sort.By[R] {
+	return f.BoolGetter
+}
+
+func (f boolField[R]) Type() reflect.Type {
+	return reflect.TypeFor[bool]()
+}
+
+func (f boolField[R]) Getter() any {
+	return f.BoolGetter
+}
+
+func (f boolField[R]) Comparators() []where.ComparatorType {
+	return equalityComparators
+}
+
+// Bool creates field for bool getter.
+func Bool[R record.Record](getter record.BoolGetter[R]) Field[R] {
+	return boolField[R]{BoolGetter: getter}
+}
+
+type setField[R record.Record, T comparable] struct {
+	record.SetGetter[R, T]
+}
+
+func (f setField[R, T]) Where(cmp where.ComparatorType, values ...any) query.WhereOption[R] {
+	converted, err := ConvertAll[T](values)
+	if err != nil {
+		return whereError[R](f.Field, err)
+	}
+
+	return query.FieldSet(f.SetGetter, cmp, converted...)
+}
+
+func (f setField[R, T]) Sort() sort.By[R] {
+	return nil
+}
+
+func (f setField[R, T]) Type() reflect.Type {
+	return reflect.TypeFor[record.Set[T]]()
+}
+
+func (f setField[R, T]) Getter() any {
+	return f.SetGetter
+}
+
+func (f setField[R, T]) Comparators() []where.ComparatorType {
+	return setComparators
+}
+
+// Set creates field for set getter.
+func Set[R record.Record, T comparable](getter record.SetGetter[R, T]) Field[R] {
+	return setField[R, T]{SetGetter: getter}
+}
+
+type sliceField[R record.Record, T record.LessComparable] struct {
+	record.SliceGetter[R, T]
+}
+
+func (f sliceField[R, T]) Where(cmp where.ComparatorType, values ...any) query.WhereOption[R] {
+	if cmp != where.SliceAny {
+		converted, err := ConvertAll[T](values)
+		if err != nil {
+			return whereError[R](f.Field, err)
+		}
+
+		return query.FieldSlice(f.SliceGetter, cmp, converted...)
+	}
+
+	if len(values) == 0 {
+		return whereError[R](f.Field, fmt.Errorf("%w: %d", ErrInvalidValuesCount, len(values)))
+	}
+
+	elementCmp, err := Convert[where.ComparatorType](values[0])
+	if err != nil {
+		return whereError[R](f.Field, err)
+	}
+
+	if !ValidValuesCount(elementCmp, len(values)-1) {
+		return whereError[R](f.Field, fmt.Errorf("%w: %d", ErrInvalidValuesCount, len(values)-1))
+	}
+
+	converted, err := ConvertAll[T](values[1:])
+	if err != nil {
+		return whereError[R](f.Field, err)
+	}
+
+	return query.FieldSliceAny(f.SliceGetter, elementCmp, converted...)
+}
+
+func (f sliceField[R, T]) Sort() sort.By[R] {
+	return nil
+}
+
+func (f sliceField[R, T]) Type() reflect.Type {
+	return reflect.TypeFor[[]T]()
+}
+
+func (f sliceField[R, T]) Getter() any {
+	return f.SliceGetter
+}
+
+func (f sliceField[R, T]) Comparators() []where.ComparatorType {
+	return sliceComparators
+}
+
+// Slice creates field for slice getter. Values of where.SliceAny are type of condition for element and its values.
+func Slice[R record.Record, T record.LessComparable](getter record.SliceGetter[R, T]) Field[R] {
+	return sliceField[R, T]{SliceGetter: getter}
+}
+
+type mapField[R record.Record, K comparable, V any] struct {
+	record.MapGetter[R, K, V]
+}
+
+func (f mapField[R, K, V]) Where(cmp where.ComparatorType, values ...any) query.WhereOption[R] {
+	switch cmp { //nolint:exhaustive
+	case where.MapHasKey:
+		keys, err := ConvertAll[K](values)
+		if err != nil {
+			return whereError[R](f.Field, err)
+		}
+
+		converted := make([]any, len(keys))
+		for i, key := range keys {
+			converted[i] = key
+		}
+
+		return query.FieldMap(f.MapGetter, cmp, converted...)
+	case where.MapKeyValueEQ:
+		if len(values) != 2 { //nolint:mnd
+			return whereError[R](f.Field, fmt.Errorf("%w: %d", ErrInvalidValuesCount, len(values)))
+		}
+
+		key, err := Convert[K](values[0])
+		if err != nil {
+			return whereError[R](f.Field, err)
+		}
+
+		value, err := Convert[V](values[1])
+		if err != nil {
+			return whereError[R](f.Field, err)
+		}
+
+		return query.FieldMap(f.MapGetter, cmp, key, value)
+	default:
+		return query.FieldMap(f.MapGetter, cmp, values...)
+	}
+}
+
+func (f mapField[R, K, V]) Sort() sort.By[R] {
+	return nil
+}
+
+func (f mapField[R, K, V]) Type() reflect.Type {
+	return reflect.TypeFor[record.Map[K, V]]()
+}
+
+func (f mapField[R, K, V]) Getter() any {
+	return f.MapGetter
+}
+
+func (f mapField[R, K, V]) Comparators() []where.ComparatorType {
+	return mapComparators
+}
+
+// Map creates field for map getter.
+func Map[R record.Record, K comparable, V any](getter record.MapGetter[R, K, V]) Field[R] {
+	return mapField[R, K, V]{MapGetter: getter}
+}
+
+type nullableField[R record.Record, T record.LessComparable] struct {
+	record.NullableGetter[R, T]
+}
+
+func (f nullableField[R, T]) Where(cmp where.ComparatorType, values ...any) query.WhereOption[R] {
+	values, bounds, err := rangeBounds(cmp, values)
+	if err != nil {
+		return whereError[R](f.Field, err)
+	}
+
+	converted, err := ConvertAll[T](values)
+	if err != nil {
+		return whereError[R](f.Field, err)
+	}
+
+	if bounds != where.Inclusive {
+		return query.FieldNullableBetween(f.NullableGetter, converted[0], converted[1], bounds)
+	}
+
+	return query.FieldNullable(f.NullableGetter, cmp, converted...)
+}
+
+func (f nullableField[R, T]) Sort() sort.By[R] {
+	return f.NullableGetter
+}
+
+func (f nullableField[R, T]) Type() reflect.Type {
+	return reflect.TypeFor[T]()
+}
+
+func (f nullableField[R, T]) Getter() any {
+	return f.NullableGetter
+}
+
+func (f nullableField[R, T]) Comparators() []where.ComparatorType {
+	return nullableComparators
+}
+
+// Nullable creates field for nullable getter.
+func Nullable[R record.Record, T record.LessComparable](getter record.NullableGetter[R, T]) Field[R] {
+	return nullableField[R, T]{NullableGetter: getter}
+}
+
+type timeField[R record.Record] struct {
+	record.TimeGetter[R]
+}
+
+func (f timeField[R]) Where(cmp where.ComparatorType, values ...any) query.WhereOption[R] {
+	values, bounds, err := rangeBounds(cmp, values)
+	if err != nil {
+		return whereError[R](f.Field, err)
+	}
+
+	converted, err := ConvertAll[time.Time](values)
+	if err != nil {
+		return whereError[R](f.Field, err)
+	}
+
+	if bounds != where.Inclusive {
+		return query.FieldTimeBetween(f.TimeGetter, converted[0], converted[1], bounds)
+	}
+
+	return query.FieldTime(f.TimeGetter, cmp, converted...)
+}
+
+func (f timeField[R]) Sort() sort.By[R] {
+	return f.TimeGetter
+}
+
+func (f timeField[R]) Type() reflect.Type {
+	return reflect.TypeFor[time.Time]()
+}
+
+func (f timeField[R]) Getter() any {
+	return f.TimeGetter
+}
+
+func (f timeField[R]) Comparators() []where.ComparatorType {
+	return orderedComparators
+}
+
+// Time creates field for time getter, values are time.Time or strings in RFC 3339 format.
+func Time[R record.Record](getter record.TimeGetter[R]) Field[R] {
+	return timeField[R]{TimeGetter: getter}
+}
@@ -0,0 +1,86 @@
+package registry
+
+import (
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/shamcode/simd/indexes"
+	"github.com/shamcode/simd/query"
+	"github.com/shamcode/simd/record"
+	"github.com/shamcode/simd/where"
+)
+
+// Registry maps field names to fields of record type and indexes of fields.
+type Registry[R record.Record] struct {
+	fields  map[string]Field[R]
+	indexes map[string][]indexes.Index[R]
+}
+
+// Add registers fields, field names must be unique.
+func (r *Registry[R]) Add(fields ...Field[R]) error {
+	for _, field := range fields {
+		name := field.String()
+		if _, exists := r.fields[name]; exists {
+			return NewDuplicateFieldError(name)
+		}
+
+		r.fields[name] = field
+	}
+
+	return nil
+}
+
+// Get returns field by name.
+func (r *Registry[R]) Get(name string) (Field[R], bool) {
+	field, ok := r.fields[name]
+	return field, ok
+}
+
+// Fields returns registered fields sorted by name.
+func (r *Registry[R]) Fields() []Field[R] {
+	return slices.SortedFunc(maps.Values(r.fields), func(a, b Field[R]) int {
+		return strings.Compare(a.String(), b.String())
+	})
+}
+
+// AddIndex registers indexes of fields, fields of indexes must be registered.
+// Indexes must be added to namespace separately.
+func (r *Registry[R]) AddIndex(added ...indexes.Index[R]) error {
+	for _, index := range added {
+		name := index.Field().String()
+		if _, exists := r.fields[name]; !exists {
+			return NewFieldNotFoundError(name)
+		}
+
+		r.indexes[name] = append(r.indexes[name], index)
+	}
+
+	return nil
+}
+
+// Indexes returns indexes of the field with passed name.
+func (r *Registry[R]) Indexes(name string) []indexes.Index[R] {
+	return r.indexes[name]
+}
+
+// Where creates condition for the field with passed name, values are converted to the field type.
+// Type of condition must be supported by the field.
+func (r *Registry[R]) Where(name string, cmp where.ComparatorType, values ...any) query.WhereOption[R] {
+	field, ok := r.fields[name]
+	if !ok {
+		return query.WhereOption[R]{
+			Cmp:   nil,
+			Error: NewFieldNotFoundError(name),
+		}
+	}
+
+	return Where(field, cmp, values...)
+}
+
+func New[R record.Record]() *Registry[R] {
+	return &Registry[R]{
+		fields:  make(map[string]Field[R]),
+		indexes: make(map[string][]indexes.Index[R]),
+	}
+}
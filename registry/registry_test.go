@@ -0,0 +1,173 @@
+//nolint:exhaustruct
+package registry
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	asserts "github.com/shamcode/assert"
+	"github.com/shamcode/simd/indexes/hash"
+	"github.com/shamcode/simd/record"
+	"github.com/shamcode/simd/where"
+)
+
+type user struct {
+	id       int64
+	name     string
+	isOnline bool
+	tags     []string
+	created  time.Time
+}
+
+func (u *user) GetID() int64 { return u.id }
+
+var userFields = record.NewFields()
+
+var (
+	id   = record.NewIDGetter[*user]()
+	name = record.ComparableGetter[*user, string]{
+		Field: userFields.New("name"),
+		Get:   func(item *user) string { return item.name },
+	}
+	isOnline = record.BoolGetter[*user]{
+		Field: userFields.New("is_online"),
+		Get:   func(item *user) bool { return item.isOnline },
+	}
+	tags = record.SliceGetter[*user, string]{
+		Field: userFields.New("tags"),
+		Get:   func(item *user) []string { return item.tags },
+	}
+	createdAt = record.TimeGetter[*user]{
+		Field: userFields.New("created_at"),
+		Get:   func(item *user) time.Time { return item.created },
+	}
+)
+
+func TestRegistry(t *testing.T) {
+	fields := New[*user]()
+	asserts.Success(t, fields.Add(
+		Comparable(name),
+		Comparable(id),
+		Bool(isOnline),
+		Slice(tags),
+		Time(createdAt),
+	))
+
+	t.Run("duplicate", func(t *testing.T) {
+		err := fields.Add(Comparable(name))
+		asserts.Equals(t, true, errors.Is(err, DuplicateFieldError{}), "is error")
+	})
+
+	t.Run("fields", func(t *testing.T) {
+		testCases := []struct {
+			name        string
+			typ         reflect.Type
+			comparators []where.ComparatorType
+		}{
+			{
+				name:        "ID",
+				typ:         reflect.TypeFor[int64](),
+				comparators: orderedComparators,
+			},
+			{
+				name:        "created_at",
+				typ:         reflect.TypeFor[time.Time](),
+				comparators: orderedComparators,
+			},
+			{
+				name:        "is_online",
+				typ:         reflect.TypeFor[bool](),
+				comparators: equalityComparators,
+			},
+			{
+				name:        "name",
+				typ:         reflect.TypeFor[string](),
+				comparators: stringComparators,
+			},
+			{
+				name:        "tags",
+				typ:         reflect.TypeFor[[]string](),
+				comparators: sliceComparators,
+			},
+		}
+
+		registered := fields.Fields()
+		asserts.Equals(t, len(testCases), len(registered), "count")
+
+		for i, testCase := range testCases {
+			asserts.Equals(t, testCase.name, registered[i].String(), "name")
+			asserts.Equals(t, testCase.typ, registered[i].Type(), "type")
+			asserts.Equals(t, testCase.comparators, registered[i].Comparators(), "comparators")
+		}
+
+		field, ok := fields.Get("name")
+		asserts.Equals(t, true, ok, "get")
+		asserts.Equals(t, true, field.Getter().(record.ComparableGetter[*user, string]).Field == name.Field, "getter")
+	})
+
+	t.Run("where", func(t *testing.T) {
+		option := fields.Where("name", where.StartsWith, "fo")
+		asserts.Success(t, option.Error)
+
+		res, err := option.Cmp.Compare(&user{name: "foo"})
+		asserts.Success(t, err)
+		asserts.Equals(t, true, res, "result")
+
+		option = fields.Where("ID", where.Like, "1")
+		asserts.Equals(t, true, errors.Is(option.Error, UnsupportedComparatorError{}), "unsupported comparator")
+		asserts.Equals(t, "ID: unsupported type of condition: 7", option.Error.Error(), "error")
+
+		option = fields.Where("ID", where.InArray, 1, 2.0)
+		asserts.Success(t, option.Error)
+		asserts.Equals(t, []any{int64(1), int64(2)}, []any{option.Cmp.ValueAt(0), option.Cmp.ValueAt(1)}, "converted")
+
+		option = fields.Where("created_at", where.GE, "2022-03-01T10:00:00+03:00")
+		asserts.Success(t, option.Error)
+
+		res, err = option.Cmp.Compare(&user{created: time.Date(2022, time.March, 1, 7, 0, 0, 0, time.UTC)})
+		asserts.Success(t, err)
+		asserts.Equals(t, true, res, "time result")
+
+		option = fields.Where("ID", where.Between, 1, 3, where.ExcludeHigh)
+		asserts.Success(t, option.Error)
+		asserts.Equals(t, where.ExcludeHigh, where.BoundsOf(option.Cmp), "bounds")
+
+		res, err = option.Cmp.Compare(&user{id: 3})
+		asserts.Success(t, err)
+		asserts.Equals(t, false, res, "excluded high")
+
+		option = fields.Where("ID", where.Between, 1, 3, 4)
+		asserts.Equals(t, true, errors.Is(option.Error, ConvertValueError{}), "invalid bounds")
+
+		for _, values := range [][]any{nil, {1, 2}} {
+			option = fields.Where("ID", where.EQ, values...)
+			asserts.Equals(t, true, errors.Is(option.Error, ErrInvalidValuesCount), "EQ values count")
+		}
+
+		option = fields.Where("ID", where.Between, 1)
+		asserts.Equals(t, true, errors.Is(option.Error, ErrInvalidValuesCount), "Between values count")
+		asserts.Equals(t, "ID: invalid values count: 1", option.Error.Error(), "values count error")
+
+		option = fields.Where("tags", where.SliceAny, where.Between, "a")
+		asserts.Equals(t, true, errors.Is(option.Error, ErrInvalidValuesCount), "SliceAny values count")
+
+		option = fields.Where("age", where.EQ, 1)
+		asserts.Equals(t, true, errors.Is(option.Error, FieldNotFoundError{}), "field not found")
+	})
+
+	t.Run("indexes", func(t *testing.T) {
+		index := hash.NewComparableHashIndex(name, false)
+		asserts.Success(t, fields.AddIndex(index))
+		asserts.Equals(t, 1, len(fields.Indexes("name")), "indexes")
+		asserts.Equals(t, 0, len(fields.Indexes("ID")), "without indexes")
+
+		unknown := record.ComparableGetter[*user, int]{
+			Field: userFields.New("age"),
+			Get:   func(*user) int { return 0 },
+		}
+		err := fields.AddIndex(hash.NewComparableHashIndex(unknown, false))
+		asserts.Equals(t, true, errors.Is(err, FieldNotFoundError{}), "field not found")
+	})
+}
@@ -23,6 +23,10 @@ type asc[R record.Record] struct{ By[R] }
 
 func (asc[R]) order() {}
 
+func (a asc[R]) unwrap() (By[R], bool) {
+	return a.By, false
+}
+
 func (a asc[R]) String() string {
 	return a.By.String() + " ASC"
 }
@@ -40,6 +44,10 @@ func (d desc[R]) Less(a, b R) bool {
 	return d.By.Less(b, a)
 }
 
+func (d desc[R]) unwrap() (By[R], bool) {
+	return d.By, true
+}
+
 func (d desc[R]) String() string {
 	return d.By.String() + " DESC"
 }
@@ -48,3 +56,42 @@ func (d desc[R]) String() string {
 func Desc[R record.Record](by By[R]) ByWithOrder[R] {
 	return desc[R]{by}
 }
+
+// Unwrap returns wrapped By and flag of descending direction.
+func Unwrap[R record.Record](by ByWithOrder[R]) (By[R], bool) {
+	if wrapped, ok := by.(interface{ unwrap() (By[R], bool) }); ok {
+		return wrapped.unwrap()
+	}
+
+	return by, false
+}
+
+// Preparer is implemented by sortings, which depend on state of storage, for example statistics of index.
+// Executor calls Prepare once per query execution and sorts records by the returned sorting.
+type Preparer[R record.Record] interface {
+	Prepare() By[R]
+}
+
+// Prepare returns sorting for a query execution with the same direction, see Preparer.
+func Prepare[R record.Record](by ByWithOrder[R]) ByWithOrder[R] {
+	unwrapped, descending := Unwrap(by)
+
+	preparer, ok := unwrapped.(Preparer[R])
+	if !ok {
+		return by
+	}
+
+	if descending {
+		return Desc(preparer.Prepare())
+	}
+
+	return Asc(preparer.Prepare())
+}
+
+// IsPrepared checks that sorting doesn't require preparing for each query execution, see Preparer.
+func IsPrepared[R record.Record](by ByWithOrder[R]) bool {
+	unwrapped, _ := Unwrap(by)
+	_, ok := unwrapped.(Preparer[R])
+
+	return !ok
+}
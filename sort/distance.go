@@ -0,0 +1,36 @@
+package sort
+
+import (
+	"strconv"
+
+	"github.com/shamcode/simd/distance"
+	"github.com/shamcode/simd/record"
+)
+
+type byDistance[R record.Record] struct {
+	getter record.GetterInterface[R, string]
+	term   string
+	metric distance.Metric
+}
+
+func (bd byDistance[R]) Less(a, b R) bool {
+	return bd.metric.Distance(bd.getter.GetForRecord(a), bd.term) <
+		bd.metric.Distance(bd.getter.GetForRecord(b), bd.term)
+}
+
+func (bd byDistance[R]) String() string {
+	return bd.metric.String() + "(" + bd.getter.String() + ", " + strconv.Quote(bd.term) + ")"
+}
+
+// ByDistance creates sorting by edit distance between string field and term, use Asc for the nearest first.
+func ByDistance[R record.Record](
+	getter record.GetterInterface[R, string],
+	term string,
+	metric distance.Metric,
+) By[R] {
+	return byDistance[R]{
+		getter: getter,
+		term:   term,
+		metric: metric,
+	}
+}
@@ -0,0 +1,23 @@
+package sort
+
+import (
+	"github.com/shamcode/simd/fold"
+	"github.com/shamcode/simd/record"
+)
+
+type byFolded[R record.Record] struct {
+	getter record.GetterInterface[R, string]
+}
+
+func (bf byFolded[R]) Less(a, b R) bool {
+	return fold.String(bf.getter.GetForRecord(a)) < fold.String(bf.getter.GetForRecord(b))
+}
+
+func (bf byFolded[R]) String() string {
+	return bf.getter.String()
+}
+
+// ByFolded creates case- and accent-insensitive sorting by string field.
+func ByFolded[R record.Record](getter record.GetterInterface[R, string]) By[R] {
+	return byFolded[R]{getter: getter}
+}
@@ -0,0 +1,81 @@
+package sort
+
+import "github.com/shamcode/simd/record"
+
+// NullsOrder defines placement of NULL values regardless of sorting direction.
+type NullsOrder uint8
+
+const (
+	NullsFirst NullsOrder = iota + 1
+	NullsLast
+)
+
+type nullable[R record.Record, T record.LessComparable] struct {
+	getter     record.NullableGetter[R, T]
+	descending bool
+	nulls      NullsOrder
+}
+
+func (nullable[R, T]) order() {}
+
+func (n nullable[R, T]) Less(a, b R) bool {
+	aValue, aValid := n.getter.Get(a)
+	bValue, bValid := n.getter.Get(b)
+
+	switch {
+	case !aValid || !bValid:
+		if aValid == bValid {
+			return false
+		}
+
+		return aValid == (n.nulls == NullsLast)
+	case n.descending:
+		return bValue < aValue
+	default:
+		return aValue < bValue
+	}
+}
+
+func (n nullable[R, T]) unwrap() (By[R], bool) {
+	return n.getter, n.descending
+}
+
+func (n nullable[R, T]) String() string {
+	res := n.getter.String()
+
+	if n.descending {
+		res += " DESC"
+	} else {
+		res += " ASC"
+	}
+
+	if n.nulls == NullsLast {
+		return res + " NULLS LAST"
+	}
+
+	return res + " NULLS FIRST"
+}
+
+// AscNullable sorts by nullable field in ASC (Ascending) direction with NULL values placed first or last.
+func AscNullable[R record.Record, T record.LessComparable](
+	getter record.NullableGetter[R, T],
+	nulls NullsOrder,
+) ByWithOrder[R] {
+	return nullable[R, T]{
+		getter:     getter,
+		descending: false,
+		nulls:      nulls,
+	}
+}
+
+// DescNullable sorts by nullable field in DESC (Descending) direction with NULL values placed first or last.
+func DescNullable[R record.Record, T record.LessComparable](
+	getter record.NullableGetter[R, T],
+	nulls NullsOrder,
+) ByWithOrder[R] {
+	return nullable[R, T]{
+		getter:     getter,
+		descending: true,
+		nulls:      nulls,
+	}
+}
@@ -0,0 +1,32 @@
+package storage
+
+var _ IDIterator = (*except)(nil)
+
+// except iterates IDs of all, which aren't contained in excluded.
+type except struct {
+	all      IDIterator
+	excluded []IDIterator
+}
+
+func (e except) Iterate(f func(id int64)) {
+	skip := make(map[int64]struct{})
+	for _, ids := range e.excluded {
+		ids.Iterate(func(id int64) {
+			skip[id] = struct{}{}
+		})
+	}
+
+	e.all.Iterate(func(id int64) {
+		if _, ok := skip[id]; !ok {
+			f(id)
+		}
+	})
+}
+
+// Except creates iterator by IDs of all, which aren't contained in excluded.
+func Except(all IDIterator, excluded []IDIterator) IDIterator {
+	return except{
+		all:      all,
+		excluded: excluded,
+	}
+}
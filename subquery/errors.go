@@ -0,0 +1,21 @@
+package subquery
+
+import "github.com/shamcode/simd/record"
+
+// SubqueryError is an error of executing the inner query.
+type SubqueryError struct { //nolint:revive
+	Selected record.Field
+	Err      error
+}
+
+func (e SubqueryError) Error() string {
+	return "subquery SELECT " + e.Selected.String() + ": " + e.Err.Error()
+}
+
+func (e SubqueryError) Unwrap() error {
+	return e.Err
+}
+
+func NewSubqueryError(selected record.Field, err error) error {
+	return SubqueryError{Selected: selected, Err: err}
+}
@@ -0,0 +1,86 @@
+// Package subquery implements conditions by results of queries to other namespaces, like
+// user_id IN (SELECT id FROM users WHERE country = "DE").
+//
+// The inner query is executed when the condition is created, values of the selected field are streamed
+// from the inner cursor into a set. The condition is a where.InArray (where.NotInArray) condition,
+// so hash, btree and trie indexes of the outer field are applied. The result of the inner query
+// is a snapshot: changes of the other namespace after creating the condition aren't visible.
+package subquery
+
+import (
+	"context"
+
+	"github.com/shamcode/simd/executor"
+	"github.com/shamcode/simd/query"
+	"github.com/shamcode/simd/record"
+	"github.com/shamcode/simd/where"
+	"github.com/shamcode/simd/where/comparators"
+)
+
+// In creates condition: value of the field is in values of the selected field of records of the inner query.
+func In[R record.Record, U record.Record, T record.LessComparable](
+	ctx context.Context,
+	getter record.ComparableGetter[R, T],
+	innerExecutor executor.QueryExecutor[U],
+	inner query.Query[U],
+	selected record.GetterInterface[U, T],
+) query.WhereOption[R] {
+	return field(ctx, getter, where.InArray, innerExecutor, inner, selected)
+}
+
+// NotIn creates condition: value of the field isn't in values of the selected field of records of the inner query.
+func NotIn[R record.Record, U record.Record, T record.LessComparable](
+	ctx context.Context,
+	getter record.ComparableGetter[R, T],
+	innerExecutor executor.QueryExecutor[U],
+	inner query.Query[U],
+	selected record.GetterInterface[U, T],
+) query.WhereOption[R] {
+	return field(ctx, getter, where.NotInArray, innerExecutor, inner, selected)
+}
+
+func field[R record.Record, U record.Record, T record.LessComparable](
+	ctx context.Context,
+	getter record.ComparableGetter[R, T],
+	cmp where.ComparatorType,
+	innerExecutor executor.QueryExecutor[U],
+	inner query.Query[U],
+	selected record.GetterInterface[U, T],
+) query.WhereOption[R] {
+	values, err := Select(ctx, innerExecutor, inner, selected)
+	if err != nil {
+		return query.WhereOption[R]{
+			Cmp:   nil,
+			Error: query.GetterError{Field: getter.Field, Err: err},
+		}
+	}
+
+	return query.WhereOption[R]{
+		Cmp:   comparators.NewInSetComparator[R](cmp, getter, values),
+		Error: nil,
+	}
+}
+
+// Select executes the query and returns set of values of the selected field of records.
+func Select[U record.Record, T comparable](
+	ctx context.Context,
+	innerExecutor executor.QueryExecutor[U],
+	inner query.Query[U],
+	selected record.GetterInterface[U, T],
+) (map[T]struct{}, error) {
+	cursor, err := innerExecutor.FetchAll(ctx, inner)
+	if err != nil {
+		return nil, NewSubqueryError(selected, err)
+	}
+
+	values := make(map[T]struct{}, cursor.Size())
+	for item := range cursor.Seq(ctx) {
+		values[selected.GetForRecord(item)] = struct{}{}
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, NewSubqueryError(selected, err)
+	}
+
+	return values, nil
+}
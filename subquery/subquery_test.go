@@ -0,0 +1,150 @@
+//nolint:exhaustruct
+package subquery
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	asserts "github.com/shamcode/assert"
+	"github.com/shamcode/simd/executor"
+	"github.com/shamcode/simd/indexes/hash"
+	"github.com/shamcode/simd/namespace"
+	"github.com/shamcode/simd/query"
+	"github.com/shamcode/simd/record"
+	"github.com/shamcode/simd/sort"
+	"github.com/shamcode/simd/where"
+)
+
+type user struct {
+	id      int64
+	country string
+}
+
+func (u *user) GetID() int64 { return u.id }
+
+type order struct {
+	id     int64
+	userID int64
+}
+
+func (o *order) GetID() int64 { return o.id }
+
+var userFields = record.NewFields()
+
+var userID = record.NewIDGetter[*user]()
+
+var userCountry = record.ComparableGetter[*user, string]{
+	Field: userFields.New("country"),
+	Get:   func(item *user) string { return item.country },
+}
+
+var orderFields = record.NewFields()
+
+var orderID = record.NewIDGetter[*order]()
+
+var orderUserID = record.ComparableGetter[*order, int64]{
+	Field: orderFields.New("user_id"),
+	Get:   func(item *order) int64 { return item.userID },
+}
+
+type logger struct {
+	messages []string
+}
+
+func (l *logger) Println(_ context.Context, msg string, _ ...any) {
+	l.messages = append(l.messages, msg)
+}
+
+func TestIn(t *testing.T) {
+	users := namespace.CreateNamespace[*user]()
+	users.AddIndex(hash.NewComparableHashIndex(userCountry, false))
+
+	for _, item := range []*user{
+		{id: 1, country: "DE"},
+		{id: 2, country: "FR"},
+		{id: 3, country: "DE"},
+		{id: 4, country: "US"},
+	} {
+		asserts.Success(t, users.Insert(item))
+	}
+
+	orders := namespace.CreateNamespace[*order]()
+	orders.AddIndex(hash.NewComparableHashIndex(orderUserID, false))
+
+	for _, item := range []*order{
+		{id: 10, userID: 1},
+		{id: 11, userID: 2},
+		{id: 12, userID: 3},
+		{id: 13, userID: 4},
+		{id: 14, userID: 1},
+		{id: 15, userID: 4},
+	} {
+		asserts.Success(t, orders.Insert(item))
+	}
+
+	usersExecutor := executor.CreateQueryExecutor[*user](users)
+	ordersExecutor := executor.CreateQueryExecutor[*order](orders)
+
+	fromCountry := func(country string) query.Query[*user] {
+		return query.NewBuilder[*user]().Where(query.Field(userCountry, where.EQ, country)).Query()
+	}
+
+	testCases := []struct {
+		name        string
+		condition   query.WhereOption[*order]
+		expectedIDs []int64
+	}{
+		{
+			name:        "user_id IN (SELECT id FROM users WHERE country = DE)",
+			condition:   In(t.Context(), orderUserID, usersExecutor, fromCountry("DE"), userID),
+			expectedIDs: []int64{10, 12, 14},
+		},
+		{
+			name:        "user_id IN (SELECT id FROM users WHERE country = UK)",
+			condition:   In(t.Context(), orderUserID, usersExecutor, fromCountry("UK"), userID),
+			expectedIDs: []int64{},
+		},
+		{
+			name:        "user_id NOT IN (SELECT id FROM users WHERE country = DE)",
+			condition:   NotIn(t.Context(), orderUserID, usersExecutor, fromCountry("DE"), userID),
+			expectedIDs: []int64{11, 13, 15},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			log := &logger{}
+			orders.SetLogger(log)
+
+			q := query.NewBuilder[*order]().
+				Where(testCase.condition).
+				Sort(sort.Asc(orderID)).
+				Query()
+
+			cursor, err := ordersExecutor.FetchAll(t.Context(), q)
+			asserts.Success(t, err)
+
+			ids := make([]int64, 0, cursor.Size())
+			for item := range cursor.Seq(t.Context()) {
+				ids = append(ids, item.id)
+			}
+
+			asserts.Equals(t, testCase.expectedIDs, ids, "ids")
+			asserts.Equals(t, 0, len(log.messages), "index applied")
+		})
+	}
+
+	t.Run("error", func(t *testing.T) {
+		invalid := query.NewBuilder[*user]().CloseBracket().Query()
+
+		q := query.NewBuilder[*order]().
+			Where(In(t.Context(), orderUserID, usersExecutor, invalid, userID)).
+			Query()
+
+		err := q.Error()
+		asserts.Equals(t, true, errors.Is(err, query.ErrCloseBracketWithoutOpen), "is error")
+		asserts.Equals(t, true, errors.As(err, &SubqueryError{}), "as subquery error")
+		asserts.Equals(t, "user_id: subquery SELECT ID: validate query: close bracket without open", err.Error(), "error")
+	})
+}
@@ -1,6 +1,11 @@
 package tests
 
-import "github.com/shamcode/simd/record"
+import (
+	"iter"
+	"maps"
+
+	"github.com/shamcode/simd/record"
+)
 
 type StatusEnum uint8
 
@@ -48,6 +53,14 @@ func (t Tags) Has(item Tag) bool {
 	return ok
 }
 
+func (t Tags) Len() int {
+	return len(t)
+}
+
+func (t Tags) All() iter.Seq[Tag] {
+	return maps.Keys(t)
+}
+
 type CounterKey uint16
 
 const (
@@ -76,6 +89,16 @@ func (c Counters) HasValue(check record.MapValueComparator[uint32]) (bool, error
 	return false, nil
 }
 
+// Get and All implement optional record.MapEntries, which is required by map index.
+func (c Counters) Get(key CounterKey) (uint32, bool) {
+	value, ok := c[key]
+	return value, ok
+}
+
+func (c Counters) All() iter.Seq2[CounterKey, uint32] {
+	return maps.All(c)
+}
+
 type HasCounterValueEqual uint32
 
 func (c HasCounterValueEqual) Compare(item uint32) (bool, error) {
@@ -123,6 +146,8 @@ var userTags = record.SetGetter[*User, Tag]{
 	Get:   func(item *User) record.Set[Tag] { return item.Tags },
 }
 
+var userTagsCount = record.SetSize(userFields.New("tags_count"), userTags)
+
 var userCounters = record.MapGetter[*User, CounterKey, uint32]{
 	Field: userFields.New("counters"),
 	Get:   func(item *User) record.Map[CounterKey, uint32] { return item.Counters },
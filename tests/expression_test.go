@@ -0,0 +1,122 @@
+//nolint:exhaustruct
+package tests
+
+import (
+	"errors"
+	"testing"
+
+	asserts "github.com/shamcode/assert"
+	"github.com/shamcode/simd/executor"
+	"github.com/shamcode/simd/expr"
+	"github.com/shamcode/simd/indexes/btree"
+	"github.com/shamcode/simd/indexes/hash"
+	"github.com/shamcode/simd/namespace"
+	"github.com/shamcode/simd/query"
+	"github.com/shamcode/simd/sort"
+	"github.com/shamcode/simd/where"
+)
+
+func Test_PredicateAndExpression(t *testing.T) {
+	// Arrange
+	store := namespace.CreateNamespace[*Campaign]()
+	store.AddIndex(hash.NewComparableHashIndex(campaignSpent, false))
+	store.AddIndex(btree.NewComparableBTreeIndex(campaignBudget, 16, false))
+
+	for _, item := range []*Campaign{
+		{ID: 1, Spent: 100, Budget: 50},
+		{ID: 2, Spent: 10, Budget: 50},
+		{ID: 3, Spent: 50, Budget: 50},
+		{ID: 4, Spent: 0, Budget: 0},
+	} {
+		asserts.Success(t, store.Insert(item))
+	}
+
+	isEven := query.Predicate("is_even", func(item *Campaign) (bool, error) {
+		return item.ID%2 == 0, nil
+	})
+
+	testCases := []struct {
+		Name        string
+		Query       query.Query[*Campaign]
+		ExpectedIDs []int64
+	}{
+		{
+			Name: "WHERE PREDICATE(is_even)",
+			Query: query.NewBuilder[*Campaign]().
+				Where(isEven).
+				Sort(sort.Asc(campaignID)).
+				Query(),
+			ExpectedIDs: []int64{2, 4},
+		},
+		{
+			Name: "WHERE NOT PREDICATE(is_even) AND spent = 50",
+			Query: query.NewBuilder[*Campaign]().
+				Not().
+				Where(isEven).
+				Where(query.Field(campaignSpent, where.EQ, 50)).
+				Query(),
+			ExpectedIDs: []int64{3},
+		},
+		{
+			Name: "WHERE spent * 2 - budget > 0",
+			Query: query.NewBuilder[*Campaign]().
+				Where(query.Expression(
+					expr.Sub(expr.Mul(expr.Field(campaignSpent), expr.Value[*Campaign](2)), expr.Field(campaignBudget)),
+					where.GT,
+					expr.Value[*Campaign](0),
+				)).
+				Sort(sort.Asc(campaignID)).
+				Query(),
+			ExpectedIDs: []int64{1, 3},
+		},
+		{
+			Name: "WHERE budget = 50 AND abs(spent - budget) <= 40",
+			Query: query.NewBuilder[*Campaign]().
+				Where(query.Field(campaignBudget, where.EQ, 50)).
+				Where(query.Expression(
+					expr.Abs(expr.Sub(expr.Field(campaignSpent), expr.Field(campaignBudget))),
+					where.LE,
+					expr.Value[*Campaign](40),
+				)).
+				Sort(sort.Asc(campaignID)).
+				Query(),
+			ExpectedIDs: []int64{2, 3},
+		},
+	}
+
+	qe := executor.CreateQueryExecutor[*Campaign](store)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			cursor, err := qe.FetchAll(t.Context(), testCase.Query)
+
+			// Assert
+			asserts.Success(t, err)
+
+			ids := make([]int64, 0, cursor.Size())
+			for item := range cursor.Seq(t.Context()) {
+				ids = append(ids, item.ID)
+			}
+
+			asserts.Success(t, cursor.Err())
+			asserts.Equals(t, testCase.ExpectedIDs, ids, "ids")
+		})
+	}
+
+	t.Run("error", func(t *testing.T) {
+		// Act
+		_, err := qe.FetchAll(t.Context(), query.NewBuilder[*Campaign]().
+			Where(query.Expression(
+				expr.Div(expr.Field(campaignSpent), expr.Field(campaignBudget)),
+				where.GT,
+				expr.Value[*Campaign](1),
+			)).
+			Query())
+
+		// Assert
+		asserts.Equals(t, true, errors.Is(err, expr.ErrDivisionByZero), "is error")
+	})
+}
@@ -0,0 +1,125 @@
+//nolint:exhaustruct
+package tests
+
+import (
+	"testing"
+
+	asserts "github.com/shamcode/assert"
+	"github.com/shamcode/simd/executor"
+	"github.com/shamcode/simd/indexes/btree"
+	"github.com/shamcode/simd/indexes/hash"
+	"github.com/shamcode/simd/namespace"
+	"github.com/shamcode/simd/query"
+	"github.com/shamcode/simd/record"
+	"github.com/shamcode/simd/sort"
+	"github.com/shamcode/simd/where"
+)
+
+type Campaign struct {
+	ID     int64
+	Spent  int
+	Budget int
+}
+
+func (c *Campaign) GetID() int64 { return c.ID }
+
+var campaignFields = record.NewFields()
+
+var campaignID = record.NewIDGetter[*Campaign]()
+
+var campaignSpent = record.ComparableGetter[*Campaign, int]{
+	Field: campaignFields.New("spent"),
+	Get:   func(item *Campaign) int { return item.Spent },
+}
+
+var campaignBudget = record.ComparableGetter[*Campaign, int]{
+	Field: campaignFields.New("budget"),
+	Get:   func(item *Campaign) int { return item.Budget },
+}
+
+func Test_FieldToField(t *testing.T) {
+	// Arrange
+	store := namespace.CreateNamespace[*Campaign]()
+	store.AddIndex(hash.NewComparableHashIndex(campaignSpent, false))
+	store.AddIndex(btree.NewComparableBTreeIndex(campaignBudget, 16, false))
+
+	for _, item := range []*Campaign{
+		{ID: 1, Spent: 100, Budget: 50},
+		{ID: 2, Spent: 10, Budget: 50},
+		{ID: 3, Spent: 50, Budget: 50},
+		{ID: 4, Spent: 0, Budget: 0},
+	} {
+		asserts.Success(t, store.Insert(item))
+	}
+
+	testCases := []struct {
+		Name        string
+		Query       query.Query[*Campaign]
+		ExpectedIDs []int64
+	}{
+		{
+			Name: "WHERE spent >= budget",
+			Query: query.NewBuilder[*Campaign]().
+				Where(query.FieldToField(campaignSpent, where.GE, campaignBudget)).
+				Sort(sort.Asc(campaignID)).
+				Query(),
+			ExpectedIDs: []int64{1, 3, 4},
+		},
+		{
+			Name: "WHERE spent != budget AND budget = 50",
+			Query: query.NewBuilder[*Campaign]().
+				Where(query.FieldToField(campaignSpent, where.NE, campaignBudget)).
+				Where(query.Field(campaignBudget, where.EQ, 50)).
+				Sort(sort.Asc(campaignID)).
+				Query(),
+			ExpectedIDs: []int64{1, 2},
+		},
+		{
+			Name: "WHERE (spent < budget OR budget = 0) AND NOT spent = budget",
+			Query: query.NewBuilder[*Campaign]().
+				OpenBracket().
+				Where(query.FieldToField(campaignSpent, where.LT, campaignBudget)).
+				Or().
+				Where(query.Field(campaignBudget, where.EQ, 0)).
+				CloseBracket().
+				Not().
+				Where(query.FieldToField(campaignSpent, where.EQ, campaignBudget)).
+				Sort(sort.Asc(campaignID)).
+				Query(),
+			ExpectedIDs: []int64{2},
+		},
+		{
+			Name: "WHERE spent = 10 OR NOT spent > budget",
+			Query: query.NewBuilder[*Campaign]().
+				Where(query.Field(campaignSpent, where.EQ, 10)).
+				Or().
+				Not().
+				Where(query.FieldToField(campaignSpent, where.GT, campaignBudget)).
+				Sort(sort.Asc(campaignID)).
+				Query(),
+			ExpectedIDs: []int64{2, 3, 4},
+		},
+	}
+
+	qe := executor.CreateQueryExecutor[*Campaign](store)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			cursor, err := qe.FetchAll(t.Context(), testCase.Query)
+
+			// Assert
+			asserts.Success(t, err)
+
+			ids := make([]int64, 0, cursor.Size())
+			for item := range cursor.Seq(t.Context()) {
+				ids = append(ids, item.ID)
+			}
+
+			asserts.Success(t, cursor.Err())
+			asserts.Equals(t, testCase.ExpectedIDs, ids, "ids")
+		})
+	}
+}
@@ -0,0 +1,121 @@
+//nolint:exhaustruct
+package tests
+
+import (
+	"testing"
+
+	asserts "github.com/shamcode/assert"
+	"github.com/shamcode/simd/executor"
+	"github.com/shamcode/simd/indexes/btree"
+	"github.com/shamcode/simd/indexes/hash"
+	"github.com/shamcode/simd/namespace"
+	"github.com/shamcode/simd/query"
+	"github.com/shamcode/simd/sort"
+	"github.com/shamcode/simd/where"
+)
+
+func Test_Folded(t *testing.T) {
+	// Arrange
+	store := namespace.CreateNamespace[*User]()
+	store.AddIndex(hash.NewFoldedStringHashIndex(userName, false))
+	store.AddIndex(btree.NewFoldedStringBTreeIndex(userName, 16, false))
+	store.AddIndex(hash.NewComparableHashIndex(userName, false))
+
+	for _, item := range []*User{
+		{ID: 1, Name: "Foo@X.com"},
+		{ID: 2, Name: "foo@x.com"},
+		{ID: 3, Name: "Crème Brûlée"},
+		{ID: 4, Name: "creme"},
+		{ID: 5, Name: "Bar"},
+	} {
+		asserts.Success(t, store.Insert(item))
+	}
+
+	asserts.Success(t, store.Upsert(&User{ID: 5, Name: "BAZ"}))
+
+	testCases := []struct {
+		Name        string
+		Query       query.Query[*User]
+		ExpectedIDs []int64
+	}{
+		{
+			Name: "WHERE fold(name) = 'FOO@x.COM'",
+			Query: query.NewBuilder[*User]().
+				Where(query.FieldFolded(userName, where.EQ, "FOO@x.COM")).
+				Sort(sort.Asc(userID)).
+				Query(),
+			ExpectedIDs: []int64{1, 2},
+		},
+		{
+			Name: "WHERE name = 'foo@x.com'",
+			Query: query.NewBuilder[*User]().
+				Where(query.Field(userName, where.EQ, "foo@x.com")).
+				Sort(sort.Asc(userID)).
+				Query(),
+			ExpectedIDs: []int64{2},
+		},
+		{
+			Name: "WHERE fold(name) IN ('CREME', 'baz')",
+			Query: query.NewBuilder[*User]().
+				Where(query.FieldFolded(userName, where.InArray, "CREME", "baz")).
+				Sort(sort.Asc(userID)).
+				Query(),
+			ExpectedIDs: []int64{4, 5},
+		},
+		{
+			Name: "WHERE fold(name) != 'Foo@X.com'",
+			Query: query.NewBuilder[*User]().
+				Where(query.FieldFolded(userName, where.NE, "Foo@X.com")).
+				Sort(sort.Asc(userID)).
+				Query(),
+			ExpectedIDs: []int64{3, 4, 5},
+		},
+		{
+			Name: "WHERE fold(name) LIKE 'CRÈME'",
+			Query: query.NewBuilder[*User]().
+				Where(query.FieldFolded(userName, where.Like, "CRÈME")).
+				Sort(sort.Asc(userID)).
+				Query(),
+			ExpectedIDs: []int64{3, 4},
+		},
+		{
+			Name: "WHERE fold(name) >= 'C' AND fold(name) < 'D'",
+			Query: query.NewBuilder[*User]().
+				Where(query.FieldFolded(userName, where.GE, "C")).
+				Where(query.FieldFolded(userName, where.LT, "D")).
+				Sort(sort.Asc(userID)).
+				Query(),
+			ExpectedIDs: []int64{3, 4},
+		},
+		{
+			Name: "ORDER BY fold(name) ASC",
+			Query: query.NewBuilder[*User]().
+				Sort(sort.Asc(sort.ByFolded[*User](userName))).
+				Sort(sort.Asc(userID)).
+				Query(),
+			ExpectedIDs: []int64{5, 4, 3, 1, 2},
+		},
+	}
+
+	qe := executor.CreateQueryExecutor[*User](store)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			cursor, err := qe.FetchAll(t.Context(), testCase.Query)
+
+			// Assert
+			asserts.Success(t, err)
+
+			ids := make([]int64, 0, cursor.Size())
+			for item := range cursor.Seq(t.Context()) {
+				ids = append(ids, item.ID)
+			}
+
+			asserts.Success(t, cursor.Err())
+			asserts.Equals(t, testCase.ExpectedIDs, ids, "ids")
+		})
+	}
+}
@@ -0,0 +1,101 @@
+//nolint:exhaustruct
+package tests
+
+import (
+	"testing"
+
+	asserts "github.com/shamcode/assert"
+	"github.com/shamcode/simd/distance"
+	"github.com/shamcode/simd/executor"
+	"github.com/shamcode/simd/indexes/bktree"
+	"github.com/shamcode/simd/namespace"
+	"github.com/shamcode/simd/query"
+	"github.com/shamcode/simd/sort"
+)
+
+func Test_Fuzzy(t *testing.T) {
+	// Arrange
+	store := namespace.CreateNamespace[*User]()
+	store.AddIndex(bktree.NewStringBKTreeIndex(userName, distance.Levenshtein, false))
+
+	for _, item := range []*User{
+		{ID: 1, Name: "jonathan"},
+		{ID: 2, Name: "jonh"},
+		{ID: 3, Name: "john"},
+		{ID: 4, Name: "joan"},
+		{ID: 5, Name: "bob"},
+	} {
+		asserts.Success(t, store.Insert(item))
+	}
+
+	asserts.Success(t, store.Upsert(&User{ID: 5, Name: "johan"}))
+
+	testCases := []struct {
+		Name        string
+		Query       query.Query[*User]
+		ExpectedIDs []int64
+	}{
+		{
+			Name: "WHERE damerau(name, 'john') <= 1 ORDER BY damerau(name, 'john'), id",
+			Query: query.NewBuilder[*User]().
+				Where(query.FieldFuzzy(userName, "john", 1, distance.Damerau)).
+				Sort(sort.Asc(sort.ByDistance(userName, "john", distance.Damerau))).
+				Sort(sort.Asc(userID)).
+				Query(),
+			ExpectedIDs: []int64{3, 2, 4, 5},
+		},
+		{
+			Name: "WHERE levenshtein(name, 'john') <= 1",
+			Query: query.NewBuilder[*User]().
+				Where(query.FieldFuzzy(userName, "john", 1, distance.Levenshtein)).
+				Sort(sort.Asc(userID)).
+				Query(),
+			ExpectedIDs: []int64{3, 4, 5},
+		},
+		{
+			Name: "WHERE NOT damerau(name, 'john') <= 1",
+			Query: query.NewBuilder[*User]().
+				Not().
+				Where(query.FieldFuzzy(userName, "john", 1, distance.Damerau)).
+				Sort(sort.Asc(userID)).
+				Query(),
+			ExpectedIDs: []int64{1},
+		},
+		{
+			Name: "WHERE levenshtein(name, 'jonathon') <= 1",
+			Query: query.NewBuilder[*User]().
+				Where(query.FieldFuzzy(userName, "jonathon", 1, distance.Levenshtein)).
+				Query(),
+			ExpectedIDs: []int64{1},
+		},
+		{
+			Name: "WHERE damerau(name, 'jhon') <= 0",
+			Query: query.NewBuilder[*User]().
+				Where(query.FieldFuzzy(userName, "jhon", 0, distance.Damerau)).
+				Query(),
+			ExpectedIDs: []int64{},
+		},
+	}
+
+	qe := executor.CreateQueryExecutor[*User](store)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			cursor, err := qe.FetchAll(t.Context(), testCase.Query)
+
+			// Assert
+			asserts.Success(t, err)
+
+			ids := make([]int64, 0, cursor.Size())
+			for item := range cursor.Seq(t.Context()) {
+				ids = append(ids, item.ID)
+			}
+
+			asserts.Success(t, cursor.Err())
+			asserts.Equals(t, testCase.ExpectedIDs, ids, "ids")
+		})
+	}
+}
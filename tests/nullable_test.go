@@ -0,0 +1,183 @@
+//nolint:exhaustruct
+package tests
+
+import (
+	"testing"
+
+	asserts "github.com/shamcode/assert"
+	"github.com/shamcode/simd/executor"
+	"github.com/shamcode/simd/indexes/btree"
+	"github.com/shamcode/simd/indexes/hash"
+	"github.com/shamcode/simd/namespace"
+	"github.com/shamcode/simd/query"
+	"github.com/shamcode/simd/record"
+	"github.com/shamcode/simd/sort"
+	"github.com/shamcode/simd/where"
+)
+
+type Profile struct {
+	ID    int64
+	Age   *int
+	Level *int
+}
+
+func (p *Profile) GetID() int64 { return p.ID }
+
+var profileFields = record.NewFields()
+
+var profileID = record.NewIDGetter[*Profile]()
+
+func optional(get func(item *Profile) *int) func(item *Profile) (int, bool) {
+	return func(item *Profile) (int, bool) {
+		if value := get(item); value != nil {
+			return *value, true
+		}
+
+		return 0, false
+	}
+}
+
+var profileAge = record.NullableGetter[*Profile, int]{
+	Field: profileFields.New("age"),
+	Get:   optional(func(item *Profile) *int { return item.Age }),
+}
+
+var profileLevel = record.NullableGetter[*Profile, int]{
+	Field: profileFields.New("level"),
+	Get:   optional(func(item *Profile) *int { return item.Level }),
+}
+
+func ptr(value int) *int {
+	return &value
+}
+
+func Test_Nullable(t *testing.T) {
+	// Arrange
+	store := namespace.CreateNamespace[*Profile]()
+	store.AddIndex(btree.NewNullableBTreeIndex(profileAge, 16, false))
+	store.AddIndex(hash.NewNullableHashIndex(profileLevel, false))
+
+	for _, item := range []*Profile{
+		{ID: 1, Age: ptr(30), Level: ptr(1)},
+		{ID: 2, Age: nil, Level: ptr(2)},
+		{ID: 3, Age: ptr(20), Level: nil},
+		{ID: 4, Age: nil, Level: nil},
+		{ID: 5, Age: ptr(40), Level: ptr(1)},
+	} {
+		asserts.Success(t, store.Insert(item))
+	}
+
+	// NULL value is moved to value and back
+	asserts.Success(t, store.Upsert(&Profile{ID: 6, Age: ptr(25), Level: ptr(3)}))
+	asserts.Success(t, store.Upsert(&Profile{ID: 6, Age: nil, Level: ptr(3)}))
+
+	testCases := []struct {
+		Name        string
+		Query       query.Query[*Profile]
+		ExpectedIDs []int64
+	}{
+		{
+			Name: "WHERE age IS NULL",
+			Query: query.NewBuilder[*Profile]().
+				Where(query.FieldNullable(profileAge, where.IsNull)).
+				Sort(sort.Asc(profileID)).
+				Query(),
+			ExpectedIDs: []int64{2, 4, 6},
+		},
+		{
+			Name: "WHERE age IS NOT NULL",
+			Query: query.NewBuilder[*Profile]().
+				Where(query.FieldNullable(profileAge, where.IsNotNull)).
+				Sort(sort.Asc(profileID)).
+				Query(),
+			ExpectedIDs: []int64{1, 3, 5},
+		},
+		{
+			Name: "WHERE age < 35",
+			Query: query.NewBuilder[*Profile]().
+				Where(query.FieldNullable(profileAge, where.LT, 35)).
+				Sort(sort.Asc(profileID)).
+				Query(),
+			ExpectedIDs: []int64{1, 3},
+		},
+		{
+			Name: "WHERE NOT age < 35",
+			Query: query.NewBuilder[*Profile]().
+				Not().
+				Where(query.FieldNullable(profileAge, where.LT, 35)).
+				Sort(sort.Asc(profileID)).
+				Query(),
+			ExpectedIDs: []int64{2, 4, 5, 6},
+		},
+		{
+			Name: "WHERE level != 1",
+			Query: query.NewBuilder[*Profile]().
+				Where(query.FieldNullable(profileLevel, where.NE, 1)).
+				Sort(sort.Asc(profileID)).
+				Query(),
+			ExpectedIDs: []int64{2, 3, 4, 6},
+		},
+		{
+			Name: "WHERE level = 1",
+			Query: query.NewBuilder[*Profile]().
+				Where(query.FieldNullable(profileLevel, where.EQ, 1)).
+				Sort(sort.Asc(profileID)).
+				Query(),
+			ExpectedIDs: []int64{1, 5},
+		},
+		{
+			Name: "ORDER BY age ASC",
+			Query: query.NewBuilder[*Profile]().
+				Sort(sort.Asc(profileAge)).
+				Sort(sort.Asc(profileID)).
+				Query(),
+			ExpectedIDs: []int64{2, 4, 6, 3, 1, 5},
+		},
+		{
+			Name: "ORDER BY age ASC NULLS LAST",
+			Query: query.NewBuilder[*Profile]().
+				Sort(sort.AscNullable(profileAge, sort.NullsLast)).
+				Sort(sort.Asc(profileID)).
+				Query(),
+			ExpectedIDs: []int64{3, 1, 5, 2, 4, 6},
+		},
+		{
+			Name: "ORDER BY age DESC NULLS LAST",
+			Query: query.NewBuilder[*Profile]().
+				Sort(sort.DescNullable(profileAge, sort.NullsLast)).
+				Sort(sort.Asc(profileID)).
+				Query(),
+			ExpectedIDs: []int64{5, 1, 3, 2, 4, 6},
+		},
+		{
+			Name: "ORDER BY age DESC NULLS FIRST",
+			Query: query.NewBuilder[*Profile]().
+				Sort(sort.DescNullable(profileAge, sort.NullsFirst)).
+				Sort(sort.Asc(profileID)).
+				Query(),
+			ExpectedIDs: []int64{2, 4, 6, 5, 1, 3},
+		},
+	}
+
+	qe := executor.CreateQueryExecutor[*Profile](store)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			cursor, err := qe.FetchAll(t.Context(), testCase.Query)
+
+			// Assert
+			asserts.Success(t, err)
+
+			ids := make([]int64, 0, cursor.Size())
+			for item := range cursor.Seq(t.Context()) {
+				ids = append(ids, item.ID)
+			}
+
+			asserts.Success(t, cursor.Err())
+			asserts.Equals(t, testCase.ExpectedIDs, ids, "ids")
+		})
+	}
+}
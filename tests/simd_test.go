@@ -10,6 +10,7 @@ import (
 	"github.com/shamcode/simd/executor"
 	"github.com/shamcode/simd/indexes/btree"
 	"github.com/shamcode/simd/indexes/hash"
+	"github.com/shamcode/simd/indexes/multivalue"
 	"github.com/shamcode/simd/namespace"
 	"github.com/shamcode/simd/query"
 	"github.com/shamcode/simd/sort"
@@ -24,6 +25,8 @@ func Test_FetchAllAndTotal(t *testing.T) { //nolint:maintidx
 	store.AddIndex(hash.NewComparableHashIndex(userStatus, false))
 	store.AddIndex(hash.NewBoolHashIndex(userIsOnline, false))
 	store.AddIndex(btree.NewComparableBTreeIndex(userScore, 16, false))
+	store.AddIndex(multivalue.NewSetIndex(userTags))
+	store.AddIndex(multivalue.NewMapIndex(userCounters))
 	asserts.Success(t, store.Insert(&User{
 		ID:     1,
 		Name:   "First",
@@ -105,6 +108,64 @@ func Test_FetchAllAndTotal(t *testing.T) { //nolint:maintidx
 			ExpectedCount: 2,
 			ExpectedIDs:   []int64{1, 4},
 		},
+		{
+			Name: "SELECT *, COUNT(*) WHERE status NE DISABLED ORDER BY id ASC",
+			Query: query.NewBuilder[*User]().
+				Where(query.Field(userStatus, where.NE, StatusDisabled)).
+				Sort(sort.Asc(userID)).
+				Query(),
+			ExpectedCount: 2,
+			ExpectedIDs:   []int64{1, 4},
+		},
+		{
+			Name: "SELECT *, COUNT(*) WHERE NOT status NE DISABLED ORDER BY id ASC",
+			Query: query.NewBuilder[*User]().
+				Not().
+				Where(query.Field(userStatus, where.NE, StatusDisabled)).
+				Sort(sort.Asc(userID)).
+				Query(),
+			ExpectedCount: 2,
+			ExpectedIDs:   []int64{2, 3},
+		},
+		{
+			Name: "SELECT *, COUNT(*) WHERE name NOT IN (First, Third) AND score NOT IN (25) ORDER BY id ASC",
+			Query: query.NewBuilder[*User]().
+				Where(query.Field(userName, where.NotInArray, "First", "Third")).
+				Where(query.Field(userScore, where.NotInArray, 25)).
+				Sort(sort.Asc(userID)).
+				Query(),
+			ExpectedCount: 1,
+			ExpectedIDs:   []int64{2},
+		},
+		{
+			Name: "SELECT *, COUNT(*) WHERE name NOT IN (First, First) ORDER BY id ASC",
+			Query: query.NewBuilder[*User]().
+				Where(query.Field(userName, where.NotInArray, "First", "First")).
+				Sort(sort.Asc(userID)).
+				Query(),
+			ExpectedCount: 3,
+			ExpectedIDs:   []int64{2, 3, 4},
+		},
+		{
+			Name: "SELECT *, COUNT(*) WHERE score NOT IN (25, 25) ORDER BY id ASC",
+			Query: query.NewBuilder[*User]().
+				Where(query.Field(userScore, where.NotInArray, 25, 25)).
+				Sort(sort.Asc(userID)).
+				Query(),
+			ExpectedCount: 3,
+			ExpectedIDs:   []int64{1, 2, 3},
+		},
+		{
+			Name: "SELECT *, COUNT(*) WHERE id NE 1 OR status NE ACTIVE ORDER BY id ASC",
+			Query: query.NewBuilder[*User]().
+				Where(query.Field(userID, where.NE, 1)).
+				Or().
+				Where(query.Field(userStatus, where.NE, StatusActive)).
+				Sort(sort.Asc(userID)).
+				Query(),
+			ExpectedCount: 3,
+			ExpectedIDs:   []int64{2, 3, 4},
+		},
 		{
 			Name: "SELECT *, COUNT(*) WHERE score >= 10 AND score < 20 ORDER BY id ASC",
 			Query: query.NewBuilder[*User]().
@@ -278,6 +339,147 @@ func Test_FetchAllAndTotal(t *testing.T) { //nolint:maintidx
 			ExpectedCount: 1,
 			ExpectedIDs:   []int64{4},
 		},
+		{
+			Name: "SELECT *, COUNT(*) WHERE (status = ACTIVE OR score = 15) AND (score < 12 OR name = Third) ORDER BY id ASC",
+			Query: query.NewBuilder[*User]().
+				OpenBracket().
+				Where(query.Field(userStatus, where.EQ, StatusActive)).
+				Or().
+				Where(query.Field(userScore, where.EQ, 15)).
+				CloseBracket().
+				OpenBracket().
+				Where(query.Field(userScore, where.LT, 12)).
+				Or().
+				Where(query.Field(userName, where.EQ, "Third")).
+				CloseBracket().
+				Sort(sort.Asc(userID)).
+				Query(),
+			ExpectedCount: 1,
+			ExpectedIDs:   []int64{1},
+		},
+		{
+			Name: "SELECT *, COUNT(*) WHERE status = ACTIVE AND score > 20 OR (name = Second) ORDER BY id ASC",
+			Query: query.NewBuilder[*User]().
+				Where(query.Field(userStatus, where.EQ, StatusActive)).
+				Where(query.Field(userScore, where.GT, 20)).
+				Or().
+				OpenBracket().
+				Where(query.Field(userName, where.EQ, "Second")).
+				CloseBracket().
+				Sort(sort.Asc(userID)).
+				Query(),
+			ExpectedCount: 2,
+			ExpectedIDs:   []int64{2, 4},
+		},
+		{
+			Name: "SELECT *, COUNT(*) WHERE ((name = First) OR (name = Second)) AND (() score > 12) ORDER BY id ASC",
+			Query: query.NewBuilder[*User]().
+				OpenBracket().
+				OpenBracket().
+				Where(query.Field(userName, where.EQ, "First")).
+				CloseBracket().
+				Or().
+				OpenBracket().
+				Where(query.Field(userName, where.EQ, "Second")).
+				CloseBracket().
+				CloseBracket().
+				OpenBracket().
+				OpenBracket().
+				CloseBracket().
+				Where(query.Field(userScore, where.GT, 12)).
+				CloseBracket().
+				Sort(sort.Asc(userID)).
+				Query(),
+			ExpectedCount: 1,
+			ExpectedIDs:   []int64{2},
+		},
+		{
+			Name: "SELECT *, COUNT(*) WHERE NOT (status = ACTIVE OR score = 15) ORDER BY id ASC",
+			Query: query.NewBuilder[*User]().
+				Not().
+				OpenBracket().
+				Where(query.Field(userStatus, where.EQ, StatusActive)).
+				Or().
+				Where(query.Field(userScore, where.EQ, 15)).
+				CloseBracket().
+				Sort(sort.Asc(userID)).
+				Query(),
+			ExpectedCount: 1,
+			ExpectedIDs:   []int64{3},
+		},
+		{
+			Name: "SELECT *, COUNT(*) WHERE id = 1 OR NOT (status = DISABLED AND NOT (score > 18 OR is_online = true)) ORDER BY id ASC",
+			Query: query.NewBuilder[*User]().
+				Where(query.Field(userID, where.EQ, 1)).
+				Or().
+				Not().
+				OpenBracket().
+				Where(query.Field(userStatus, where.EQ, StatusDisabled)).
+				Not().
+				OpenBracket().
+				Where(query.Field(userScore, where.GT, 18)).
+				Or().
+				Where(query.FieldBool(userIsOnline, where.EQ, true)).
+				CloseBracket().
+				CloseBracket().
+				Sort(sort.Asc(userID)).
+				Query(),
+			ExpectedCount: 3,
+			ExpectedIDs:   []int64{1, 3, 4},
+		},
+		{
+			Name: "SELECT *, COUNT(*) WHERE NOT (name = First OR NOT (score < 20 AND NOT is_online = true)) AND NOT (NOT (score > 12)) ORDER BY id ASC",
+			Query: query.NewBuilder[*User]().
+				Not().
+				OpenBracket().
+				Where(query.Field(userName, where.EQ, "First")).
+				Or().
+				Not().
+				OpenBracket().
+				Where(query.Field(userScore, where.LT, 20)).
+				Not().
+				Where(query.FieldBool(userIsOnline, where.EQ, true)).
+				CloseBracket().
+				CloseBracket().
+				Not().
+				OpenBracket().
+				Not().
+				OpenBracket().
+				Where(query.Field(userScore, where.GT, 12)).
+				CloseBracket().
+				CloseBracket().
+				Sort(sort.Asc(userID)).
+				Query(),
+			ExpectedCount: 1,
+			ExpectedIDs:   []int64{2},
+		},
+		{
+			Name: "SELECT *, COUNT(*) WHERE (NOT (status = ACTIVE) OR id = 1) AND NOT (id = 2 OR (score > 15 AND NOT ())) ORDER BY id ASC",
+			Query: query.NewBuilder[*User]().
+				OpenBracket().
+				Not().
+				OpenBracket().
+				Where(query.Field(userStatus, where.EQ, StatusActive)).
+				CloseBracket().
+				Or().
+				Where(query.Field(userID, where.EQ, 1)).
+				CloseBracket().
+				Not().
+				OpenBracket().
+				Where(query.Field(userID, where.EQ, 2)).
+				Or().
+				OpenBracket().
+				Where(query.Field(userScore, where.GT, 15)).
+				Not().
+				OpenBracket().
+				CloseBracket().
+				CloseBracket().
+				CloseBracket().
+				Sort(sort.Asc(userID)).
+				Query(),
+			ExpectedCount: 1,
+			ExpectedIDs:   []int64{1},
+		},
 		{
 			Name: "SELECT *, COUNT(*) WHERE is_online = true ORDER BY id ASC",
 			Query: query.NewBuilder[*User]().
@@ -352,6 +554,42 @@ func Test_FetchAllAndTotal(t *testing.T) { //nolint:maintidx
 			ExpectedCount: 1,
 			ExpectedIDs:   []int64{3},
 		},
+		{
+			Name: "SELECT *, COUNT(*) WHERE tags HAS ANY (tester, free) ORDER BY id ASC",
+			Query: query.NewBuilder[*User]().
+				Where(query.FieldSet(userTags, where.SetHasAny, TagTester, TagFree)).
+				Sort(sort.Asc(userID)).
+				Query(),
+			ExpectedCount: 2,
+			ExpectedIDs:   []int64{1, 3},
+		},
+		{
+			Name: "SELECT *, COUNT(*) WHERE tags HAS ALL (confirmed, tester) ORDER BY id ASC",
+			Query: query.NewBuilder[*User]().
+				Where(query.FieldSet(userTags, where.SetHasAll, TagConfirmed, TagTester)).
+				Sort(sort.Asc(userID)).
+				Query(),
+			ExpectedCount: 1,
+			ExpectedIDs:   []int64{1},
+		},
+		{
+			Name: "SELECT *, COUNT(*) WHERE tags_count >= 2 ORDER BY id ASC",
+			Query: query.NewBuilder[*User]().
+				Where(query.Field(userTagsCount, where.GE, 2)).
+				Sort(sort.Asc(userID)).
+				Query(),
+			ExpectedCount: 2,
+			ExpectedIDs:   []int64{1, 3},
+		},
+		{
+			Name: "SELECT *, COUNT(*) WHERE tags_count = 0 ORDER BY id ASC",
+			Query: query.NewBuilder[*User]().
+				Where(query.Field(userTagsCount, where.EQ, 0)).
+				Sort(sort.Asc(userID)).
+				Query(),
+			ExpectedCount: 1,
+			ExpectedIDs:   []int64{4},
+		},
 		{
 			Name: "SELECT *, COUNT(*) WHERE counter MAP_HAS_KEY UnreadMessages ORDER BY id ASC",
 			Query: query.NewBuilder[*User]().
@@ -361,6 +599,15 @@ func Test_FetchAllAndTotal(t *testing.T) { //nolint:maintidx
 			ExpectedCount: 2,
 			ExpectedIDs:   []int64{1, 2},
 		},
+		{
+			Name: "SELECT *, COUNT(*) WHERE counter MAP_KEY_VALUE_EQ PendingTasks 1 ORDER BY id ASC",
+			Query: query.NewBuilder[*User]().
+				Where(query.FieldMap(userCounters, where.MapKeyValueEQ, CounterKeyPendingTasks, uint32(1))).
+				Sort(sort.Asc(userID)).
+				Query(),
+			ExpectedCount: 2,
+			ExpectedIDs:   []int64{1, 4},
+		},
 		{
 			Name: "SELECT *, COUNT(*) WHERE counter MAP_HAS_VALUE HasCounterValueEqual(2) ORDER BY id ASC",
 			Query: query.NewBuilder[*User]().
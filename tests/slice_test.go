@@ -0,0 +1,134 @@
+//nolint:exhaustruct
+package tests
+
+import (
+	"testing"
+
+	asserts "github.com/shamcode/assert"
+	"github.com/shamcode/simd/executor"
+	"github.com/shamcode/simd/indexes/multivalue"
+	"github.com/shamcode/simd/namespace"
+	"github.com/shamcode/simd/query"
+	"github.com/shamcode/simd/record"
+	"github.com/shamcode/simd/sort"
+	"github.com/shamcode/simd/where"
+)
+
+type Post struct {
+	ID     int64
+	Labels []string
+	Scores []int64
+}
+
+func (p *Post) GetID() int64 { return p.ID }
+
+var postFields = record.NewFields()
+
+var postID = record.NewIDGetter[*Post]()
+
+var postLabels = record.SliceGetter[*Post, string]{
+	Field: postFields.New("labels"),
+	Get:   func(item *Post) []string { return item.Labels },
+}
+
+var postScores = record.SliceGetter[*Post, int64]{
+	Field: postFields.New("scores"),
+	Get:   func(item *Post) []int64 { return item.Scores },
+}
+
+var postLabelsCount = record.SliceLen(postFields.New("labels_count"), postLabels)
+
+func Test_Slice(t *testing.T) {
+	// Arrange
+	store := namespace.CreateNamespace[*Post]()
+	store.AddIndex(multivalue.NewSliceIndex(postLabels))
+
+	for _, item := range []*Post{
+		{ID: 1, Labels: []string{"go", "db", "go"}, Scores: []int64{1, 5}},
+		{ID: 2, Labels: []string{"go"}, Scores: []int64{7}},
+		{ID: 3, Labels: []string{"db", "ml"}, Scores: nil},
+		{ID: 4, Labels: nil, Scores: []int64{2, 3}},
+	} {
+		asserts.Success(t, store.Insert(item))
+	}
+
+	asserts.Success(t, store.Upsert(&Post{ID: 4, Labels: []string{"ml"}, Scores: []int64{2, 3}}))
+
+	testCases := []struct {
+		Name        string
+		Query       query.Query[*Post]
+		ExpectedIDs []int64
+	}{
+		{
+			Name: "WHERE labels CONTAINS 'go'",
+			Query: query.NewBuilder[*Post]().
+				Where(query.FieldSlice(postLabels, where.SliceContains, "go")).
+				Sort(sort.Asc(postID)).
+				Query(),
+			ExpectedIDs: []int64{1, 2},
+		},
+		{
+			Name: "WHERE labels CONTAINS ALL ('db', 'go')",
+			Query: query.NewBuilder[*Post]().
+				Where(query.FieldSlice(postLabels, where.SliceContainsAll, "db", "go")).
+				Sort(sort.Asc(postID)).
+				Query(),
+			ExpectedIDs: []int64{1},
+		},
+		{
+			Name: "WHERE NOT labels CONTAINS 'go'",
+			Query: query.NewBuilder[*Post]().
+				Not().
+				Where(query.FieldSlice(postLabels, where.SliceContains, "go")).
+				Sort(sort.Asc(postID)).
+				Query(),
+			ExpectedIDs: []int64{3, 4},
+		},
+		{
+			Name: "WHERE ANY(scores) > 4",
+			Query: query.NewBuilder[*Post]().
+				Where(query.FieldSliceAny(postScores, where.GT, 4)).
+				Sort(sort.Asc(postID)).
+				Query(),
+			ExpectedIDs: []int64{1, 2},
+		},
+		{
+			Name: "WHERE ANY(labels) LIKE 'm'",
+			Query: query.NewBuilder[*Post]().
+				Where(query.FieldSliceAny(postLabels, where.Like, "m")).
+				Sort(sort.Asc(postID)).
+				Query(),
+			ExpectedIDs: []int64{3, 4},
+		},
+		{
+			Name: "WHERE LEN(labels) >= 2",
+			Query: query.NewBuilder[*Post]().
+				Where(query.Field(postLabelsCount, where.GE, 2)).
+				Sort(sort.Asc(postID)).
+				Query(),
+			ExpectedIDs: []int64{1, 3},
+		},
+	}
+
+	qe := executor.CreateQueryExecutor[*Post](store)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			cursor, err := qe.FetchAll(t.Context(), testCase.Query)
+
+			// Assert
+			asserts.Success(t, err)
+
+			ids := make([]int64, 0, cursor.Size())
+			for item := range cursor.Seq(t.Context()) {
+				ids = append(ids, item.ID)
+			}
+
+			asserts.Success(t, cursor.Err())
+			asserts.Equals(t, testCase.ExpectedIDs, ids, "ids")
+		})
+	}
+}
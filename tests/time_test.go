@@ -0,0 +1,154 @@
+//nolint:exhaustruct
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	asserts "github.com/shamcode/assert"
+	"github.com/shamcode/simd/executor"
+	"github.com/shamcode/simd/indexes/btree"
+	"github.com/shamcode/simd/indexes/hash"
+	"github.com/shamcode/simd/namespace"
+	"github.com/shamcode/simd/query"
+	"github.com/shamcode/simd/record"
+	"github.com/shamcode/simd/sort"
+	"github.com/shamcode/simd/where"
+)
+
+type Event struct {
+	ID        int64
+	CreatedAt time.Time
+}
+
+func (e *Event) GetID() int64 { return e.ID }
+
+var eventFields = record.NewFields()
+
+var eventID = record.NewIDGetter[*Event]()
+
+var eventCreatedAt = record.TimeGetter[*Event]{
+	Field: eventFields.New("created_at"),
+	Get:   func(item *Event) time.Time { return item.CreatedAt },
+}
+
+var moscow = time.FixedZone("+03:00", 3*60*60)
+
+var eventCreatedDay = record.TimeByDay(eventFields.New("created_day"), eventCreatedAt, moscow)
+
+type indexLogger struct {
+	messages []string
+}
+
+func (l *indexLogger) Println(_ context.Context, msg string, _ ...any) {
+	l.messages = append(l.messages, msg)
+}
+
+func Test_Time(t *testing.T) {
+	// Arrange
+	store := namespace.CreateNamespace[*Event]()
+	store.AddIndex(btree.NewTimeBTreeIndex(eventCreatedAt, 4, false))
+	store.AddIndex(hash.NewTimeHashIndex(eventCreatedDay, false))
+
+	for _, item := range []*Event{
+		{ID: 1, CreatedAt: time.Date(2022, time.March, 1, 10, 0, 0, 0, time.UTC)},
+		{ID: 2, CreatedAt: time.Date(2022, time.March, 1, 22, 0, 0, 0, time.UTC)},
+		{ID: 3, CreatedAt: time.Date(2022, time.March, 2, 1, 0, 0, 0, moscow)},
+		{ID: 4, CreatedAt: time.Date(2022, time.February, 1, 0, 0, 0, 0, time.UTC)},
+		{ID: 5, CreatedAt: time.Time{}},
+	} {
+		asserts.Success(t, store.Insert(item))
+	}
+
+	testCases := []struct {
+		Name        string
+		Query       query.Query[*Event]
+		ExpectedIDs []int64
+	}{
+		{
+			Name: "WHERE created_at = 2022-03-01T13:00:00+03:00",
+			Query: query.NewBuilder[*Event]().
+				Where(query.FieldTime(eventCreatedAt, where.EQ, time.Date(2022, time.March, 1, 13, 0, 0, 0, moscow))).
+				Query(),
+			ExpectedIDs: []int64{1},
+		},
+		{
+			Name: "WHERE created_at IN (2022-02-01, 2022-03-01T22:00:00Z)",
+			Query: query.NewBuilder[*Event]().
+				Where(query.FieldTime(
+					eventCreatedAt,
+					where.InArray,
+					time.Date(2022, time.February, 1, 0, 0, 0, 0, time.UTC),
+					time.Date(2022, time.March, 2, 1, 0, 0, 0, moscow),
+				)).
+				Sort(sort.Asc(eventID)).
+				Query(),
+			ExpectedIDs: []int64{2, 3, 4},
+		},
+		{
+			Name: "WHERE created_at < 2022-03-01",
+			Query: query.NewBuilder[*Event]().
+				Where(query.FieldTime(eventCreatedAt, where.LT, time.Date(2022, time.March, 1, 0, 0, 0, 0, time.UTC))).
+				Sort(sort.Asc(eventCreatedAt)).
+				Query(),
+			ExpectedIDs: []int64{5, 4},
+		},
+		{
+			Name: "WHERE created_at BETWEEN [2022-03-01T10:00:00Z, 2022-03-01T22:00:00Z)",
+			Query: query.NewBuilder[*Event]().
+				Where(query.FieldTimeBetween(
+					eventCreatedAt,
+					time.Date(2022, time.March, 1, 10, 0, 0, 0, time.UTC),
+					time.Date(2022, time.March, 1, 22, 0, 0, 0, time.UTC),
+					where.ExcludeHigh,
+				)).
+				Query(),
+			ExpectedIDs: []int64{1},
+		},
+		{
+			Name: "WHERE created_day = 2022-03-02 in +03:00",
+			Query: query.NewBuilder[*Event]().
+				Where(query.FieldTime(eventCreatedDay, where.EQ, time.Date(2022, time.March, 2, 0, 0, 0, 0, moscow))).
+				Sort(sort.Asc(eventID)).
+				Query(),
+			ExpectedIDs: []int64{2, 3},
+		},
+		{
+			Name: "WHERE created_day = 2022-03-01 in +03:00 ORDER BY created_at DESC",
+			Query: query.NewBuilder[*Event]().
+				Where(query.FieldTime(
+					eventCreatedDay,
+					where.EQ,
+					record.StartOfDay(time.Date(2022, time.March, 1, 15, 0, 0, 0, time.UTC), moscow),
+				)).
+				Sort(sort.Desc(eventCreatedAt)).
+				Query(),
+			ExpectedIDs: []int64{1},
+		},
+	}
+
+	qe := executor.CreateQueryExecutor[*Event](store)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			log := &indexLogger{}
+			store.SetLogger(log)
+
+			// Act
+			cursor, err := qe.FetchAll(t.Context(), testCase.Query)
+
+			// Assert
+			asserts.Success(t, err)
+
+			ids := make([]int64, 0, cursor.Size())
+			for item := range cursor.Seq(t.Context()) {
+				ids = append(ids, item.ID)
+			}
+
+			asserts.Success(t, cursor.Err())
+			asserts.Equals(t, testCase.ExpectedIDs, ids, "ids")
+			asserts.Equals(t, 0, len(log.messages), "index applied")
+		})
+	}
+}
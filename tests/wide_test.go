@@ -0,0 +1,92 @@
+//nolint:exhaustruct
+package tests
+
+import (
+	"strconv"
+	"testing"
+
+	asserts "github.com/shamcode/assert"
+	"github.com/shamcode/simd/executor"
+	"github.com/shamcode/simd/indexes/hash"
+	"github.com/shamcode/simd/namespace"
+	"github.com/shamcode/simd/query"
+	"github.com/shamcode/simd/record"
+	"github.com/shamcode/simd/sort"
+	"github.com/shamcode/simd/where"
+)
+
+// Wide is a record with more than 255 fields.
+type Wide struct {
+	ID     int64
+	Values [300]int
+}
+
+func (w *Wide) GetID() int64 { return w.ID }
+
+func Test_WideRecord(t *testing.T) {
+	fields := record.NewFields()
+	getters := make([]record.ComparableGetter[*Wide, int], len(Wide{}.Values))
+
+	for i := range getters {
+		getters[i] = record.ComparableGetter[*Wide, int]{
+			Field: fields.New("value_" + strconv.Itoa(i)),
+			Get:   func(item *Wide) int { return item.Values[i] },
+		}
+	}
+
+	wideID := record.NewIDGetter[*Wide]()
+	last := getters[len(getters)-1]
+
+	// Index of the field 256 was wrapped to 0 and collided with ID
+	asserts.Equals(t, uint16(300), last.Index(), "index")
+
+	store := namespace.CreateNamespace[*Wide]()
+	store.AddIndex(hash.NewComparableHashIndex(wideID, true))
+	store.AddIndex(hash.NewComparableHashIndex(getters[255], false))
+	store.AddIndex(hash.NewComparableHashIndex(last, false))
+
+	for id := range int64(4) {
+		item := &Wide{ID: id + 1}
+		item.Values[255] = int(id % 2)
+		item.Values[len(item.Values)-1] = int(id)
+		asserts.Success(t, store.Insert(item))
+	}
+
+	testCases := []struct {
+		Name        string
+		Query       query.Query[*Wide]
+		ExpectedIDs []int64
+	}{
+		{
+			Name:        "WHERE ID = 2",
+			Query:       query.NewBuilder[*Wide]().Where(query.Field(wideID, where.EQ, 2)).Query(),
+			ExpectedIDs: []int64{2},
+		},
+		{
+			Name: "WHERE value_255 = 1 OR value_299 = 2 ORDER BY value_299 DESC",
+			Query: query.NewBuilder[*Wide]().
+				Where(query.Field(getters[255], where.EQ, 1)).
+				Or().
+				Where(query.Field(last, where.EQ, 2)).
+				Sort(sort.Desc(last)).
+				Query(),
+			ExpectedIDs: []int64{4, 3, 2},
+		},
+	}
+
+	qe := executor.CreateQueryExecutor[*Wide](store)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			cursor, err := qe.FetchAll(t.Context(), testCase.Query)
+			asserts.Success(t, err)
+
+			ids := make([]int64, 0, cursor.Size())
+			for item := range cursor.Seq(t.Context()) {
+				ids = append(ids, item.ID)
+			}
+
+			asserts.Equals(t, testCase.ExpectedIDs, ids, "ids")
+		})
+	}
+}
@@ -0,0 +1,83 @@
+// Package ast implements tree representation of where.Conditions for analyzing and rewriting queries.
+//
+// Conditions are converted to the tree by FromConditions and back by ToConditions. Passes Simplify, PushDownNot
+// and FoldDuplicates rewrite the tree, Normalize applies all of them. Fingerprint returns stable representation
+// of the tree, which doesn't depend on order of operands of AND and OR.
+package ast
+
+import (
+	"github.com/shamcode/simd/record"
+	"github.com/shamcode/simd/where"
+)
+
+// Node is a node of conditions tree: And, Or, Not, Leaf or Const.
+type Node[R record.Record] interface {
+	// Eval checks that the record satisfies the node.
+	Eval(item R) (bool, error)
+}
+
+type (
+	// And is satisfied if all nodes are satisfied, nodes are checked from left to right.
+	And[R record.Record] struct {
+		Nodes []Node[R]
+	}
+
+	// Or is satisfied if any node is satisfied, nodes are checked from left to right.
+	Or[R record.Record] struct {
+		Nodes []Node[R]
+	}
+
+	// Not negates the node.
+	Not[R record.Record] struct {
+		Node Node[R]
+	}
+
+	// Leaf is a single condition.
+	Leaf[R record.Record] struct {
+		Cmp where.FieldComparator[R]
+	}
+
+	// Const is a node with known result, it's produced by rewriting, for example A AND NOT A is false.
+	Const[R record.Record] struct {
+		Value bool
+	}
+)
+
+func (n And[R]) Eval(item R) (bool, error) {
+	for _, node := range n.Nodes {
+		res, err := node.Eval(item)
+		if err != nil || !res {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+func (n Or[R]) Eval(item R) (bool, error) {
+	for _, node := range n.Nodes {
+		res, err := node.Eval(item)
+		if err != nil || res {
+			return res, err
+		}
+	}
+
+	return false, nil
+}
+
+func (n Not[R]) Eval(item R) (bool, error) {
+	res, err := n.Node.Eval(item)
+	if err != nil {
+		return false, err
+	}
+
+	return !res, nil
+}
+
+func (n Leaf[R]) Eval(item R) (bool, error) {
+	return n.Cmp.Compare(item)
+}
+
+func (n Const[R]) Eval(R) (bool, error) {
+	return n.Value, nil
+}
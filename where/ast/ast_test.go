@@ -0,0 +1,318 @@
+//nolint:exhaustruct
+package ast_test
+
+import (
+	"math/rand/v2"
+	"strings"
+	"testing"
+
+	asserts "github.com/shamcode/assert"
+	"github.com/shamcode/simd/query"
+	"github.com/shamcode/simd/record"
+	"github.com/shamcode/simd/where"
+	"github.com/shamcode/simd/where/ast"
+	"github.com/shamcode/simd/where/comparators"
+)
+
+type item struct {
+	a, b, c int
+}
+
+func (i *item) GetID() int64 { return 0 }
+
+var fields = record.NewFields()
+
+var getters = []record.ComparableGetter[*item, int]{
+	{Field: fields.New("a"), Get: func(item *item) int { return item.a }},
+	{Field: fields.New("b"), Get: func(item *item) int { return item.b }},
+	{Field: fields.New("c"), Get: func(item *item) int { return item.c }},
+}
+
+var a, b, c = leaf(0), leaf(1), leaf(2)
+
+func leaf(field int) ast.Leaf[*item] {
+	return ast.Leaf[*item]{Cmp: comparators.NewComparableFieldComparator[*item](where.EQ, getters[field], 1)}
+}
+
+// dump writes the tree with order of operands.
+func dump(node ast.Node[*item]) string {
+	switch n := node.(type) {
+	case ast.And[*item]:
+		return dumpGroup("AND", n.Nodes)
+	case ast.Or[*item]:
+		return dumpGroup("OR", n.Nodes)
+	case ast.Not[*item]:
+		return "NOT " + dump(n.Node)
+	case ast.Leaf[*item]:
+		return n.Cmp.GetField().String()
+	case ast.Const[*item]:
+		if n.Value {
+			return "TRUE"
+		}
+
+		return "FALSE"
+	default:
+		return "?"
+	}
+}
+
+func dumpGroup(op string, nodes []ast.Node[*item]) string {
+	operands := make([]string, len(nodes))
+	for i, node := range nodes {
+		operands[i] = dump(node)
+	}
+
+	return op + "(" + strings.Join(operands, ", ") + ")"
+}
+
+func field(getter int) query.WhereOption[*item] {
+	return query.Field(getters[getter], where.EQ, 1)
+}
+
+func TestFromConditions(t *testing.T) {
+	testCases := []struct {
+		name     string
+		query    query.Query[*item]
+		expected string
+	}{
+		{
+			name:     "empty",
+			query:    query.NewBuilder[*item]().Query(),
+			expected: "TRUE",
+		},
+		{
+			name:     "left to right",
+			query:    query.NewBuilder[*item]().Where(field(0)).Or().Where(field(1)).Where(field(2)).Query(),
+			expected: "AND(OR(a, b), c)",
+		},
+		{
+			name: "brackets",
+			query: query.NewBuilder[*item]().
+				Not().
+				Where(field(0)).
+				Or().
+				OpenBracket().
+				Where(field(1)).
+				Where(field(2)).
+				CloseBracket().
+				Query(),
+			expected: "OR(NOT a, AND(b, c))",
+		},
+		{
+			name: "sibling groups",
+			query: query.NewBuilder[*item]().
+				OpenBracket().
+				Where(field(0)).
+				Or().
+				Where(field(1)).
+				CloseBracket().
+				OpenBracket().
+				Where(field(1)).
+				Or().
+				OpenBracket().
+				Where(field(2)).
+				CloseBracket().
+				CloseBracket().
+				Query(),
+			expected: "AND(OR(a, b), OR(b, c))",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			asserts.Success(t, testCase.query.Error())
+			asserts.Equals(t, testCase.expected, dump(ast.FromConditions(testCase.query.Conditions())), "tree")
+		})
+	}
+}
+
+func TestPasses(t *testing.T) {
+	testCases := []struct {
+		name     string
+		pass     func(node ast.Node[*item]) ast.Node[*item]
+		node     ast.Node[*item]
+		expected string
+	}{
+		{
+			name:     "simplify nested groups",
+			pass:     ast.Simplify[*item],
+			node:     ast.And[*item]{Nodes: []ast.Node[*item]{a, ast.And[*item]{Nodes: []ast.Node[*item]{b, ast.Or[*item]{Nodes: []ast.Node[*item]{c}}}}}},
+			expected: "AND(a, b, c)",
+		},
+		{
+			name:     "simplify constants",
+			pass:     ast.Simplify[*item],
+			node:     ast.Or[*item]{Nodes: []ast.Node[*item]{ast.And[*item]{Nodes: []ast.Node[*item]{a, ast.Const[*item]{Value: false}}}, ast.Not[*item]{Node: ast.Not[*item]{Node: b}}}},
+			expected: "b",
+		},
+		{
+			name:     "simplify empty group",
+			pass:     ast.Simplify[*item],
+			node:     ast.Not[*item]{Node: ast.Or[*item]{}},
+			expected: "TRUE",
+		},
+		{
+			name:     "push down not",
+			pass:     ast.PushDownNot[*item],
+			node:     ast.Not[*item]{Node: ast.Or[*item]{Nodes: []ast.Node[*item]{a, ast.And[*item]{Nodes: []ast.Node[*item]{ast.Not[*item]{Node: b}, c}}}}},
+			expected: "AND(NOT a, OR(b, NOT c))",
+		},
+		{
+			name:     "fold duplicates",
+			pass:     ast.FoldDuplicates[*item],
+			node:     ast.And[*item]{Nodes: []ast.Node[*item]{a, b, leaf(0), ast.Or[*item]{Nodes: []ast.Node[*item]{c, leaf(2)}}}},
+			expected: "AND(a, b, c)",
+		},
+		{
+			name:     "fold complementary",
+			pass:     ast.FoldDuplicates[*item],
+			node:     ast.Or[*item]{Nodes: []ast.Node[*item]{ast.And[*item]{Nodes: []ast.Node[*item]{a, ast.Not[*item]{Node: a}}}, ast.Not[*item]{Node: b}, b}},
+			expected: "TRUE",
+		},
+		{
+			name:     "normalize",
+			pass:     ast.Normalize[*item],
+			node:     ast.Not[*item]{Node: ast.And[*item]{Nodes: []ast.Node[*item]{ast.Not[*item]{Node: a}, ast.Or[*item]{Nodes: []ast.Node[*item]{b, ast.Not[*item]{Node: b}}}}}},
+			expected: "a",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			asserts.Equals(t, testCase.expected, dump(testCase.pass(testCase.node)), "tree")
+		})
+	}
+}
+
+func TestFingerprint(t *testing.T) {
+	first := ast.Or[*item]{Nodes: []ast.Node[*item]{ast.And[*item]{Nodes: []ast.Node[*item]{a, b}}, ast.Not[*item]{Node: c}}}
+	second := ast.Or[*item]{Nodes: []ast.Node[*item]{ast.Not[*item]{Node: c}, ast.And[*item]{Nodes: []ast.Node[*item]{b, a}}}}
+	third := ast.Not[*item]{Node: ast.And[*item]{Nodes: []ast.Node[*item]{c, ast.Or[*item]{Nodes: []ast.Node[*item]{ast.Not[*item]{Node: b}, ast.Not[*item]{Node: a}}}}}}
+
+	asserts.Equals(t, ast.Fingerprint[*item](first), ast.Fingerprint[*item](second), "order of operands")
+	asserts.Equals(t, false, ast.Fingerprint[*item](first) == ast.Fingerprint[*item](third), "not normalized")
+	asserts.Equals(t, ast.Fingerprint(ast.Normalize[*item](first)), ast.Fingerprint(ast.Normalize[*item](third)), "normalized")
+
+	// Values of the set are iterated in random order
+	inSet := ast.Leaf[*item]{Cmp: comparators.InSetComparator[*item, int]{
+		Cmp:    where.InArray,
+		Getter: getters[0],
+		Value:  map[int]struct{}{1: {}, 2: {}, 3: {}},
+	}}
+	inArray := ast.Leaf[*item]{Cmp: comparators.NewComparableFieldComparator[*item](where.InArray, getters[0], 3, 1, 2)}
+
+	asserts.Equals(t, ast.Fingerprint[*item](inArray), ast.Fingerprint[*item](inSet), "values of set")
+}
+
+// randomNode creates random tree with leaves, negations and nested groups.
+func randomNode(rnd *rand.Rand, depth int) ast.Node[*item] {
+	if depth == 0 || rnd.IntN(3) == 0 {
+		var node ast.Node[*item] = leaf(rnd.IntN(len(getters)))
+		if rnd.IntN(2) == 0 {
+			node = ast.Not[*item]{Node: node}
+		}
+
+		return node
+	}
+
+	nodes := make([]ast.Node[*item], 1+rnd.IntN(3))
+	for i := range nodes {
+		nodes[i] = randomNode(rnd, depth-1)
+	}
+
+	switch rnd.IntN(4) { //nolint:mnd
+	case 0:
+		return ast.And[*item]{Nodes: nodes}
+	case 1:
+		return ast.Or[*item]{Nodes: nodes}
+	case 2: //nolint:mnd
+		return ast.Not[*item]{Node: ast.And[*item]{Nodes: nodes}}
+	default:
+		return ast.Not[*item]{Node: ast.Or[*item]{Nodes: nodes}}
+	}
+}
+
+// build adds the tree to the builder, groups are enclosed in brackets, NOT of groups is added by .Not().OpenBracket().
+func build(builder query.DefaultBuilder[*item], node ast.Node[*item]) {
+	switch n := node.(type) {
+	case ast.Not[*item]:
+		builder.Not()
+		build(builder, n.Node)
+	case ast.Leaf[*item]:
+		builder.Where(query.WhereOption[*item]{Cmp: n.Cmp})
+	case ast.And[*item]:
+		buildGroup(builder, n.Nodes, false)
+	case ast.Or[*item]:
+		buildGroup(builder, n.Nodes, true)
+	}
+}
+
+func buildGroup(builder query.DefaultBuilder[*item], nodes []ast.Node[*item], isOr bool) {
+	builder.OpenBracket()
+
+	for i, node := range nodes {
+		if i > 0 && isOr {
+			builder.Or()
+		}
+
+		build(builder, node)
+	}
+
+	builder.CloseBracket()
+}
+
+func TestConditions(t *testing.T) {
+	var items []*item
+
+	for i := range 8 {
+		items = append(items, &item{a: i & 1, b: i >> 1 & 1, c: i >> 2 & 1})
+	}
+
+	rnd := rand.New(rand.NewPCG(1, 2)) //nolint:gosec
+
+	for range 500 {
+		node := randomNode(rnd, 4)
+		conditions := ast.ToConditions(node)
+		converted := ast.FromConditions(conditions)
+		normalized := ast.Normalize(node)
+
+		builder := query.NewBuilder[*item]()
+		build(builder, node)
+
+		built := builder.Query()
+		asserts.Success(t, built.Error())
+
+		for _, item := range items {
+			expected, err := node.Eval(item)
+			asserts.Success(t, err)
+
+			res, err := conditions.Check(item)
+			asserts.Success(t, err)
+			asserts.Equals(t, expected, res, dump(node)+": check")
+
+			res, err = built.Conditions().Check(item)
+			asserts.Success(t, err)
+			asserts.Equals(t, expected, res, dump(node)+": builder")
+
+			res, err = converted.Eval(item)
+			asserts.Success(t, err)
+			asserts.Equals(t, expected, res, dump(node)+": from conditions")
+
+			res, err = normalized.Eval(item)
+			asserts.Success(t, err)
+			asserts.Equals(t, expected, res, dump(node)+": normalized")
+		}
+	}
+}
+
+func TestToConditionsConst(t *testing.T) {
+	asserts.Equals(t, 0, len(ast.ToConditions[*item](ast.Or[*item]{Nodes: []ast.Node[*item]{a, ast.Not[*item]{Node: ast.Const[*item]{}}}})), "true")
+
+	conditions := ast.ToConditions[*item](ast.And[*item]{Nodes: []ast.Node[*item]{a, ast.Const[*item]{Value: false}}})
+	asserts.Equals(t, 1, len(conditions), "false")
+	asserts.Equals(t, "FALSE", conditions[0].Cmp.GetField().String(), "false")
+
+	res, err := conditions.Check(&item{a: 1})
+	asserts.Success(t, err)
+	asserts.Equals(t, false, res, "check")
+}
@@ -0,0 +1,157 @@
+package ast
+
+import (
+	"slices"
+
+	"github.com/shamcode/simd/record"
+	"github.com/shamcode/simd/where"
+	"github.com/shamcode/simd/where/comparators"
+)
+
+// FromConditions converts conditions to the tree. Conditions on one bracket level are applied from left to right,
+// so A OR B AND C is converted to And(Or(A, B), C). Empty conditions are converted to true.
+func FromConditions[R record.Record](conditions where.Conditions[R]) Node[R] {
+	if len(conditions) == 0 {
+		return Const[R]{Value: true}
+	}
+
+	node, _ := fromGroup(conditions, 0, 0, 1)
+
+	return node
+}
+
+// fromGroup converts group on the bracket level from pos and returns position after the group.
+func fromGroup[R record.Record](conditions where.Conditions[R], pos, opened, level int) (Node[R], int) {
+	var res Node[R]
+
+	for pos < len(conditions) {
+		inGroup, nested, nestedOpened := conditions.Item(pos, opened, level)
+		if !inGroup {
+			break
+		}
+
+		opened = 0
+		isOr := conditions[pos].IsOr
+
+		var item Node[R]
+		if nested {
+			item, pos = fromGroup(conditions, pos, nestedOpened, level+1)
+		} else {
+			item = fromCondition(conditions[pos])
+			pos++
+		}
+
+		if res == nil {
+			res = item
+		} else {
+			res = join(res, item, isOr)
+		}
+	}
+
+	return res, pos
+}
+
+// join joins item to the node, operands of the node are extended if it's a group with the same operation.
+func join[R record.Record](node Node[R], item Node[R], isOr bool) Node[R] {
+	if isOr {
+		if group, ok := node.(Or[R]); ok {
+			return Or[R]{Nodes: append(slices.Clip(group.Nodes), item)}
+		}
+
+		return Or[R]{Nodes: []Node[R]{node, item}}
+	}
+
+	if group, ok := node.(And[R]); ok {
+		return And[R]{Nodes: append(slices.Clip(group.Nodes), item)}
+	}
+
+	return And[R]{Nodes: []Node[R]{node, item}}
+}
+
+func nodesOf[R record.Record](node Node[R]) []Node[R] {
+	switch group := node.(type) {
+	case And[R]:
+		return group.Nodes
+	case Or[R]:
+		return group.Nodes
+	default:
+		return nil
+	}
+}
+
+func fromCondition[R record.Record](condition where.Condition[R]) Node[R] {
+	var node Node[R] = Leaf[R]{Cmp: condition.Cmp}
+	if condition.WithNot {
+		node = Not[R]{Node: node}
+	}
+
+	return node
+}
+
+// ToConditions converts the tree to conditions. NOT of groups is pushed down to conditions by De Morgan's laws,
+// because conditions support NOT only for single condition. Nested groups are enclosed in brackets.
+// True is converted to empty conditions, false to the PREDICATE(FALSE) condition.
+func ToConditions[R record.Record](node Node[R]) where.Conditions[R] {
+	node = Simplify(PushDownNot(node))
+
+	if constant, ok := node.(Const[R]); ok {
+		if constant.Value {
+			return nil
+		}
+
+		node = Leaf[R]{Cmp: comparators.NewPredicateComparator("FALSE", func(R) (bool, error) { return false, nil })}
+	}
+
+	var conditions where.Conditions[R]
+
+	toGroup(&conditions, node, 1, false, 0)
+
+	return conditions
+}
+
+// toGroup appends the node on the bracket level: single condition or items of the group.
+func toGroup[R record.Record](conditions *where.Conditions[R], node Node[R], level int, isOr bool, openBrackets int) {
+	var isOrGroup bool
+
+	switch group := node.(type) {
+	case And[R]:
+		isOrGroup = false
+	case Or[R]:
+		isOrGroup = true
+	case Not[R]:
+		*conditions = append(*conditions, where.Condition[R]{
+			WithNot:      true,
+			IsOr:         isOr,
+			BracketLevel: level,
+			OpenBrackets: openBrackets,
+			Cmp:          group.Node.(Leaf[R]).Cmp,
+		})
+
+		return
+	case Leaf[R]:
+		*conditions = append(*conditions, where.Condition[R]{
+			WithNot:      false,
+			IsOr:         isOr,
+			BracketLevel: level,
+			OpenBrackets: openBrackets,
+			Cmp:          group.Cmp,
+		})
+
+		return
+	}
+
+	for i, item := range nodesOf(node) {
+		itemIsOr, itemOpenBrackets := isOrGroup, 0
+		if i == 0 {
+			// The first item joins the group to the previous item and opens brackets of the group
+			itemIsOr, itemOpenBrackets = isOr, openBrackets
+		}
+
+		switch item.(type) {
+		case And[R], Or[R]:
+			toGroup(conditions, item, level+1, itemIsOr, itemOpenBrackets+1)
+		default:
+			toGroup(conditions, item, level, itemIsOr, itemOpenBrackets)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package ast
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/shamcode/simd/record"
+	"github.com/shamcode/simd/where"
+)
+
+// Fingerprint returns representation of the tree, which doesn't depend on order of operands of AND and OR groups
+// and order of values of IN, NOT IN, SET_HAS_ANY and SET_HAS_ALL conditions. Use Normalize before for comparing
+// equivalent trees. Predicates are represented by name, so it must be unique for different functions.
+func Fingerprint[R record.Record](node Node[R]) string {
+	var builder strings.Builder
+
+	writeFingerprint(&builder, node)
+
+	return builder.String()
+}
+
+func writeFingerprint[R record.Record](builder *strings.Builder, node Node[R]) {
+	switch n := node.(type) {
+	case And[R]:
+		writeGroupFingerprint(builder, "AND", n.Nodes)
+	case Or[R]:
+		writeGroupFingerprint(builder, "OR", n.Nodes)
+	case Not[R]:
+		builder.WriteString("NOT(")
+		writeFingerprint(builder, n.Node)
+		builder.WriteString(")")
+	case Leaf[R]:
+		writeLeafFingerprint(builder, n.Cmp)
+	case Const[R]:
+		builder.WriteString(strings.ToUpper(strconv.FormatBool(n.Value)))
+	default:
+		fmt.Fprintf(builder, "%T", node)
+	}
+}
+
+func writeGroupFingerprint[R record.Record](builder *strings.Builder, op string, nodes []Node[R]) {
+	operands := make([]string, len(nodes))
+	for i, node := range nodes {
+		operands[i] = Fingerprint(node)
+	}
+
+	slices.Sort(operands)
+
+	builder.WriteString(op)
+	builder.WriteString("(")
+	builder.WriteString(strings.Join(operands, ","))
+	builder.WriteString(")")
+}
+
+func writeLeafFingerprint[R record.Record](builder *strings.Builder, cmp where.FieldComparator[R]) {
+	builder.WriteString("W")
+	builder.WriteString(strconv.Itoa(int(cmp.GetField().Index())))
+
+	if where.IsComputed(cmp) {
+		// Computed fields have the same index and are identified by name
+		builder.WriteString(",")
+		builder.WriteString(strconv.Quote(cmp.GetField().String()))
+	}
+
+	builder.WriteString(",")
+	builder.WriteString(strconv.Itoa(int(cmp.GetType())))
+	builder.WriteString(",")
+	builder.WriteString(strconv.Itoa(int(where.BoundsOf(cmp))))
+	builder.WriteString(",")
+	builder.WriteString(strconv.FormatBool(where.IsFolding(cmp)))
+
+	// Values of sets are iterated without copying by comparator, order of IN values is normalized below
+	values := make([]string, 0, cmp.ValuesCount())
+	for value := range where.ValuesOf(cmp) {
+		values = append(values, fmt.Sprintf("%T:%v", value, value))
+	}
+
+	switch cmp.GetType() { //nolint:exhaustive
+	case where.InArray, where.NotInArray, where.SetHasAny, where.SetHasAll:
+		slices.Sort(values)
+	}
+
+	for _, value := range values {
+		builder.WriteString(",")
+		builder.WriteString(strconv.Quote(value))
+	}
+
+	builder.WriteString(";")
+}
@@ -0,0 +1,182 @@
+package ast
+
+import (
+	"github.com/shamcode/simd/record"
+)
+
+// Transform rewrites the tree from leaves to root: fn is called for each node after its operands are rewritten.
+func Transform[R record.Record](node Node[R], fn func(node Node[R]) Node[R]) Node[R] {
+	switch n := node.(type) {
+	case And[R]:
+		node = And[R]{Nodes: transformAll(n.Nodes, fn)}
+	case Or[R]:
+		node = Or[R]{Nodes: transformAll(n.Nodes, fn)}
+	case Not[R]:
+		node = Not[R]{Node: Transform(n.Node, fn)}
+	}
+
+	return fn(node)
+}
+
+func transformAll[R record.Record](nodes []Node[R], fn func(node Node[R]) Node[R]) []Node[R] {
+	res := make([]Node[R], len(nodes))
+	for i, node := range nodes {
+		res[i] = Transform(node, fn)
+	}
+
+	return res
+}
+
+// Simplify removes double NOT and constants, unwraps groups with single operand
+// and merges nested groups with the same operation: A AND (B AND C) is A AND B AND C.
+func Simplify[R record.Record](node Node[R]) Node[R] {
+	return Transform(node, func(node Node[R]) Node[R] {
+		switch n := node.(type) {
+		case And[R]:
+			return simplifyGroup(n.Nodes, false)
+		case Or[R]:
+			return simplifyGroup(n.Nodes, true)
+		case Not[R]:
+			switch operand := n.Node.(type) {
+			case Not[R]:
+				return operand.Node
+			case Const[R]:
+				return Const[R]{Value: !operand.Value}
+			}
+		}
+
+		return node
+	})
+}
+
+// simplifyGroup simplifies operands of AND (isOr is false) or OR (isOr is true) group,
+// which are already simplified.
+func simplifyGroup[R record.Record](nodes []Node[R], isOr bool) Node[R] {
+	res := make([]Node[R], 0, len(nodes))
+
+	for _, node := range nodes {
+		switch n := node.(type) {
+		case Const[R]:
+			if n.Value == isOr {
+				// A OR true is true, A AND false is false
+				return n
+			}
+
+			// A OR false is A, A AND true is A
+			continue
+		case And[R]:
+			if !isOr {
+				res = append(res, n.Nodes...)
+				continue
+			}
+		case Or[R]:
+			if isOr {
+				res = append(res, n.Nodes...)
+				continue
+			}
+		}
+
+		res = append(res, node)
+	}
+
+	return group(res, isOr)
+}
+
+// group creates AND (isOr is false) or OR (isOr is true) group, empty AND is true, empty OR is false.
+func group[R record.Record](nodes []Node[R], isOr bool) Node[R] {
+	switch {
+	case len(nodes) == 0:
+		return Const[R]{Value: !isOr}
+	case len(nodes) == 1:
+		return nodes[0]
+	case isOr:
+		return Or[R]{Nodes: nodes}
+	default:
+		return And[R]{Nodes: nodes}
+	}
+}
+
+// PushDownNot moves NOT to leaves by De Morgan's laws: NOT (A OR B) is NOT A AND NOT B.
+// After the pass NOT is applied to leaves only.
+func PushDownNot[R record.Record](node Node[R]) Node[R] {
+	return pushDownNot(node, false)
+}
+
+func pushDownNot[R record.Record](node Node[R], negate bool) Node[R] {
+	switch n := node.(type) {
+	case And[R]:
+		return group(pushDownNotAll(n.Nodes, negate), negate)
+	case Or[R]:
+		return group(pushDownNotAll(n.Nodes, negate), !negate)
+	case Not[R]:
+		return pushDownNot(n.Node, !negate)
+	case Const[R]:
+		return Const[R]{Value: n.Value != negate}
+	default:
+		if negate {
+			return Not[R]{Node: node}
+		}
+
+		return node
+	}
+}
+
+func pushDownNotAll[R record.Record](nodes []Node[R], negate bool) []Node[R] {
+	res := make([]Node[R], len(nodes))
+	for i, node := range nodes {
+		res[i] = pushDownNot(node, negate)
+	}
+
+	return res
+}
+
+// FoldDuplicates removes duplicate operands of groups: A AND A is A, and folds groups with complementary operands
+// to constants: A AND NOT A is false, A OR NOT A is true. Operands are compared by Fingerprint.
+func FoldDuplicates[R record.Record](node Node[R]) Node[R] {
+	return Transform(node, func(node Node[R]) Node[R] {
+		switch n := node.(type) {
+		case And[R]:
+			return foldGroup(n.Nodes, false)
+		case Or[R]:
+			return foldGroup(n.Nodes, true)
+		default:
+			return node
+		}
+	})
+}
+
+func foldGroup[R record.Record](nodes []Node[R], isOr bool) Node[R] {
+	seen := make(map[string]struct{}, len(nodes))
+	res := make([]Node[R], 0, len(nodes))
+
+	for _, node := range nodes {
+		fingerprint := Fingerprint(node)
+		if _, ok := seen[fingerprint]; ok {
+			continue
+		}
+
+		if _, ok := seen[Fingerprint(negate(node))]; ok {
+			// A OR NOT A is true, A AND NOT A is false
+			return Const[R]{Value: isOr}
+		}
+
+		seen[fingerprint] = struct{}{}
+		res = append(res, node)
+	}
+
+	return group(res, isOr)
+}
+
+// negate returns NOT node without double NOT.
+func negate[R record.Record](node Node[R]) Node[R] {
+	if n, ok := node.(Not[R]); ok {
+		return n.Node
+	}
+
+	return Not[R]{Node: node}
+}
+
+// Normalize simplifies the tree, pushes down NOT and folds duplicates.
+func Normalize[R record.Record](node Node[R]) Node[R] {
+	return Simplify(FoldDuplicates(Simplify(PushDownNot(node))))
+}
@@ -1,6 +1,10 @@
 package where
 
-import "github.com/shamcode/simd/record"
+import (
+	"iter"
+
+	"github.com/shamcode/simd/record"
+)
 
 type ComparatorType uint8
 
@@ -16,8 +20,27 @@ const (
 	SetHas
 	MapHasValue
 	MapHasKey
+	Between
+	NE
+	NotInArray
+	IsNull
+	IsNotNull
+	StartsWith
+	Match
+	Fuzzy
+	SetHasAny
+	SetHasAll
+	MapKeyValueEQ
+	SliceContains
+	SliceContainsAll
+	SliceAny
+	Predicate
 )
 
+// CustomComparatorBase is the first type of condition for custom comparators. Types less than it are reserved
+// for built-in comparators, so new built-in types don't collide with custom types: use CustomComparatorBase + n.
+const CustomComparatorBase ComparatorType = 128
+
 type FieldComparator[R record.Record] interface {
 	GetField() record.Field
 	GetType() ComparatorType
@@ -25,3 +48,101 @@ type FieldComparator[R record.Record] interface {
 	ValuesCount() int
 	ValueAt(index int) any
 }
+
+// Bounds defines inclusion of low and high values into range of Between comparator.
+type Bounds uint8
+
+const (
+	// Inclusive includes both values: low <= value <= high.
+	Inclusive Bounds = 0
+	// ExcludeLow excludes low value: low < value <= high.
+	ExcludeLow Bounds = 1
+	// ExcludeHigh excludes high value: low <= value < high.
+	ExcludeHigh Bounds = 2
+	// Exclusive excludes both values: low < value < high.
+	Exclusive = ExcludeLow | ExcludeHigh
+)
+
+func (b Bounds) IncludeLow() bool {
+	return b&ExcludeLow == 0
+}
+
+func (b Bounds) IncludeHigh() bool {
+	return b&ExcludeHigh == 0
+}
+
+// Contains checks results of comparing value with low and high values (-1, 0 or +1 like cmp.Compare).
+func (b Bounds) Contains(withLow, withHigh int) bool {
+	return (withLow > 0 || withLow == 0 && b.IncludeLow()) && (withHigh < 0 || withHigh == 0 && b.IncludeHigh())
+}
+
+// RangeComparator is implemented by comparators, which support Between with not inclusive bounds.
+type RangeComparator interface {
+	GetBounds() Bounds
+}
+
+// BoundsOf returns bounds of Between comparator, comparators without RangeComparator are inclusive.
+func BoundsOf[R record.Record](cmp FieldComparator[R]) Bounds {
+	if rangeCmp, ok := cmp.(RangeComparator); ok {
+		return rangeCmp.GetBounds()
+	}
+
+	return Inclusive
+}
+
+// FoldingComparator is implemented by comparators, which compare case- and accent-folded strings (see fold package).
+// Values of these comparators are already folded.
+type FoldingComparator interface {
+	IsFolding() bool
+}
+
+// IsFolding checks that comparator compares folded strings.
+func IsFolding[R record.Record](cmp FieldComparator[R]) bool {
+	folding, ok := cmp.(FoldingComparator)
+	return ok && folding.IsFolding()
+}
+
+// FieldsComparator is implemented by comparators of two fields of the same record.
+// Value of these comparators is other field, so indexes can't be applied.
+type FieldsComparator interface {
+	GetOtherField() record.Field
+}
+
+// IsFieldToField checks that comparator compares two fields of the same record.
+func IsFieldToField[R record.Record](cmp FieldComparator[R]) bool {
+	_, ok := cmp.(FieldsComparator)
+	return ok
+}
+
+// ComputedComparator is implemented by comparators of values computed from the whole record,
+// like predicates and expressions, so indexes can't be applied.
+type ComputedComparator interface {
+	IsComputed() bool
+}
+
+// IsComputed checks that comparator compares value computed from the whole record.
+func IsComputed[R record.Record](cmp FieldComparator[R]) bool {
+	computed, ok := cmp.(ComputedComparator)
+	return ok && computed.IsComputed()
+}
+
+// ValuesSet is implemented by InArray and NotInArray comparators, which store values in a set, for example
+// results of subqueries. Values iterates values of the set without copying.
+type ValuesSet interface {
+	Values() iter.Seq[any]
+}
+
+// ValuesOf returns iterator over values of comparator.
+func ValuesOf[R record.Record](cmp FieldComparator[R]) iter.Seq[any] {
+	if set, ok := cmp.(ValuesSet); ok {
+		return set.Values()
+	}
+
+	return func(yield func(any) bool) {
+		for i := range cmp.ValuesCount() {
+			if !yield(cmp.ValueAt(i)) {
+				return
+			}
+		}
+	}
+}
@@ -1,12 +1,21 @@
 package comparators
 
 import (
+	"cmp"
+
 	"github.com/shamcode/simd/record"
 	"github.com/shamcode/simd/where"
 )
 
 type ComparableFieldComparator[R record.Record, T record.LessComparable] struct {
 	EqualComparator[R, T]
+
+	// Bounds is used by where.Between, Value contains low and high values.
+	Bounds where.Bounds
+}
+
+func (fc ComparableFieldComparator[R, T]) GetBounds() where.Bounds {
+	return fc.Bounds
 }
 
 func (fc ComparableFieldComparator[R, T]) CompareValue(value T) (bool, error) {
@@ -19,6 +28,8 @@ func (fc ComparableFieldComparator[R, T]) CompareValue(value T) (bool, error) {
 		return value >= fc.Value[0], nil
 	case where.LE:
 		return value <= fc.Value[0], nil
+	case where.Between:
+		return fc.Bounds.Contains(cmp.Compare(value, fc.Value[0]), cmp.Compare(value, fc.Value[1])), nil
 	default:
 		return fc.EqualComparator.CompareValue(value)
 	}
@@ -29,11 +40,12 @@ func (fc ComparableFieldComparator[R, T]) Compare(item R) (bool, error) {
 }
 
 func NewComparableFieldComparator[R record.Record, T record.LessComparable](
-	cmp where.ComparatorType,
+	cmpType where.ComparatorType,
 	getter record.GetterInterface[R, T],
 	value ...T,
 ) ComparableFieldComparator[R, T] {
 	return ComparableFieldComparator[R, T]{
-		EqualComparator: NewEqualComparator(cmp, getter, value...),
+		EqualComparator: NewEqualComparator(cmpType, getter, value...),
+		Bounds:          where.Inclusive,
 	}
 }
@@ -3,10 +3,15 @@ package comparators
 
 import (
 	"errors"
+	"iter"
+	"maps"
 	"regexp"
 	"testing"
+	"time"
 
 	asserts "github.com/shamcode/assert"
+	"github.com/shamcode/simd/distance"
+	"github.com/shamcode/simd/expr"
 	"github.com/shamcode/simd/record"
 	"github.com/shamcode/simd/where"
 )
@@ -21,7 +26,10 @@ type user struct {
 	iface  any
 	mp     mp
 	set    set
+	slice  []int
 	string string
+	opt    *int
+	time   time.Time
 }
 
 func (u *user) GetID() int64 { return u.int64 }
@@ -64,6 +72,14 @@ func (s set) Has(item int) bool {
 	return ok
 }
 
+func (s set) Len() int {
+	return len(s)
+}
+
+func (s set) All() iter.Seq[int] {
+	return maps.Keys(s)
+}
+
 var fields = record.NewFields()
 
 var boolGetter = record.BoolGetter[*user]{
@@ -106,16 +122,42 @@ var mapGetter = record.MapGetter[*user, int, int]{
 	Get:   func(item *user) record.Map[int, int] { return item.mp },
 }
 
+var mapEntriesGetter = record.MapGetter[*user, int, int]{
+	Field: fields.New("map_entries"),
+	Get:   func(item *user) record.Map[int, int] { return record.MapOf[int, int](item.mp) },
+}
+
 var setGetter = record.SetGetter[*user, int]{
 	Field: fields.New("set"),
 	Get:   func(item *user) record.Set[int] { return item.set },
 }
 
+var optGetter = record.NullableGetter[*user, int]{
+	Field: fields.New("opt"),
+	Get: func(item *user) (int, bool) {
+		if item.opt == nil {
+			return 0, false
+		}
+
+		return *item.opt, true
+	},
+}
+
+var sliceGetter = record.SliceGetter[*user, int]{
+	Field: fields.New("slice"),
+	Get:   func(item *user) []int { return item.slice },
+}
+
 var stringGetter = record.ComparableGetter[*user, string]{
 	Field: fields.New("string"),
 	Get:   func(item *user) string { return item.string },
 }
 
+var timeGetter = record.TimeGetter[*user]{
+	Field: fields.New("time"),
+	Get:   func(item *user) time.Time { return item.time },
+}
+
 func TestComparators(t *testing.T) { //nolint:maintidx
 	item := &user{
 		bool:   true,
@@ -134,7 +176,9 @@ func TestComparators(t *testing.T) { //nolint:maintidx
 			1: {},
 			2: {},
 		},
+		slice:  []int{3, 5, 3},
 		string: "foo",
+		time:   time.Date(2022, time.March, 1, 10, 0, 0, 0, time.UTC),
 	}
 
 	type testCase struct {
@@ -398,6 +442,104 @@ func TestComparators(t *testing.T) { //nolint:maintidx
 				expectedField:  "int",
 				expectedValues: []any{10},
 			},
+			{
+				name: "10 BETWEEN 1 AND 10",
+				comparator: ComparableFieldComparator[*user, int]{
+					EqualComparator: NewEqualComparator[*user, int](where.Between, intGetter, 1, 10),
+					Bounds:          where.Inclusive,
+				},
+				expectedResult: true,
+				expectedCmp:    where.Between,
+				expectedField:  "int",
+				expectedValues: []any{1, 10},
+			},
+			{
+				name: "10 BETWEEN [1, 10)",
+				comparator: ComparableFieldComparator[*user, int]{
+					EqualComparator: NewEqualComparator[*user, int](where.Between, intGetter, 1, 10),
+					Bounds:          where.ExcludeHigh,
+				},
+				expectedResult: false,
+				expectedCmp:    where.Between,
+				expectedField:  "int",
+				expectedValues: []any{1, 10},
+			},
+			{
+				name: "10 BETWEEN (10, 20]",
+				comparator: ComparableFieldComparator[*user, int]{
+					EqualComparator: NewEqualComparator[*user, int](where.Between, intGetter, 10, 20),
+					Bounds:          where.ExcludeLow,
+				},
+				expectedResult: false,
+				expectedCmp:    where.Between,
+				expectedField:  "int",
+				expectedValues: []any{10, 20},
+			},
+			{
+				name: "10 BETWEEN [10, 20)",
+				comparator: ComparableFieldComparator[*user, int]{
+					EqualComparator: NewEqualComparator[*user, int](where.Between, intGetter, 10, 20),
+					Bounds:          where.ExcludeHigh,
+				},
+				expectedResult: true,
+				expectedCmp:    where.Between,
+				expectedField:  "int",
+				expectedValues: []any{10, 20},
+			},
+			{
+				name: "10 BETWEEN (1, 20)",
+				comparator: ComparableFieldComparator[*user, int]{
+					EqualComparator: NewEqualComparator[*user, int](where.Between, intGetter, 1, 20),
+					Bounds:          where.Exclusive,
+				},
+				expectedResult: true,
+				expectedCmp:    where.Between,
+				expectedField:  "int",
+				expectedValues: []any{1, 20},
+			},
+			{
+				name: "10 BETWEEN 11 AND 20",
+				comparator: ComparableFieldComparator[*user, int]{
+					EqualComparator: NewEqualComparator[*user, int](where.Between, intGetter, 11, 20),
+					Bounds:          where.Inclusive,
+				},
+				expectedResult: false,
+				expectedCmp:    where.Between,
+				expectedField:  "int",
+				expectedValues: []any{11, 20},
+			},
+			{
+				name:           "10 != 10",
+				comparator:     NewComparableFieldComparator[*user, int](where.NE, intGetter, 10),
+				expectedResult: false,
+				expectedCmp:    where.NE,
+				expectedField:  "int",
+				expectedValues: []any{10},
+			},
+			{
+				name:           "10 != 3",
+				comparator:     NewComparableFieldComparator[*user, int](where.NE, intGetter, 3),
+				expectedResult: true,
+				expectedCmp:    where.NE,
+				expectedField:  "int",
+				expectedValues: []any{3},
+			},
+			{
+				name:           "10 NOT IN (1, 2, 10)",
+				comparator:     NewComparableFieldComparator[*user, int](where.NotInArray, intGetter, 1, 2, 10),
+				expectedResult: false,
+				expectedCmp:    where.NotInArray,
+				expectedField:  "int",
+				expectedValues: []any{1, 2, 10},
+			},
+			{
+				name:           "10 NOT IN (1, 2)",
+				comparator:     NewComparableFieldComparator[*user, int](where.NotInArray, intGetter, 1, 2),
+				expectedResult: true,
+				expectedCmp:    where.NotInArray,
+				expectedField:  "int",
+				expectedValues: []any{1, 2},
+			},
 			{
 				name:           "10 IN (1, 2, 10)",
 				comparator:     NewComparableFieldComparator[*user, int](where.InArray, intGetter, 1, 2, 10),
@@ -819,6 +961,48 @@ func TestComparators(t *testing.T) { //nolint:maintidx
 					return false, errors.New("comparator error")
 				})},
 			},
+			{
+				name:           "MapKeyValueEQ 2 4",
+				comparator:     NewMapFieldComparator[*user](where.MapKeyValueEQ, mapEntriesGetter, 2, 4),
+				expectedResult: true,
+				expectedCmp:    where.MapKeyValueEQ,
+				expectedField:  "map_entries",
+				expectedValues: []any{2, 4},
+			},
+			{
+				name:           "MapKeyValueEQ 2 8",
+				comparator:     NewMapFieldComparator[*user](where.MapKeyValueEQ, mapEntriesGetter, 2, 8),
+				expectedResult: false,
+				expectedCmp:    where.MapKeyValueEQ,
+				expectedField:  "map_entries",
+				expectedValues: []any{2, 8},
+			},
+			{
+				name:           "MapKeyValueEQ 4 0",
+				comparator:     NewMapFieldComparator[*user](where.MapKeyValueEQ, mapEntriesGetter, 4, 0),
+				expectedResult: false,
+				expectedCmp:    where.MapKeyValueEQ,
+				expectedField:  "map_entries",
+				expectedValues: []any{4, 0},
+			},
+			{
+				name:           "MapKeyValueEQ cast error",
+				comparator:     NewMapFieldComparator[*user](where.MapKeyValueEQ, mapEntriesGetter, 2, "4"),
+				expectedResult: false,
+				expectedError:  NewFailCastTypeError(mapEntriesGetter.Field, where.MapKeyValueEQ, "4", "int"),
+				expectedCmp:    where.MapKeyValueEQ,
+				expectedField:  "map_entries",
+				expectedValues: []any{2, "4"},
+			},
+			{
+				name:           "MapKeyValueEQ without entries",
+				comparator:     NewMapFieldComparator[*user](where.MapKeyValueEQ, mapGetter, 2, 4),
+				expectedResult: false,
+				expectedError:  NewFailCastTypeError(mapGetter.Field, where.MapKeyValueEQ, mp{1: 1, 2: 4, 3: 8}, "record.MapEntries"),
+				expectedCmp:    where.MapKeyValueEQ,
+				expectedField:  "map",
+				expectedValues: []any{2, 4},
+			},
 			{
 				name:           "? 2",
 				comparator:     NewMapFieldComparator[*user](0, mapGetter, 2),
@@ -849,6 +1033,38 @@ func TestComparators(t *testing.T) { //nolint:maintidx
 				expectedField:  "set",
 				expectedValues: []any{3},
 			},
+			{
+				name:           "SetHasAny 3, 2",
+				comparator:     NewSetFieldComparator[*user, int](where.SetHasAny, setGetter, 3, 2),
+				expectedResult: true,
+				expectedCmp:    where.SetHasAny,
+				expectedField:  "set",
+				expectedValues: []any{3, 2},
+			},
+			{
+				name:           "SetHasAny 3, 4",
+				comparator:     NewSetFieldComparator[*user, int](where.SetHasAny, setGetter, 3, 4),
+				expectedResult: false,
+				expectedCmp:    where.SetHasAny,
+				expectedField:  "set",
+				expectedValues: []any{3, 4},
+			},
+			{
+				name:           "SetHasAll 1, 2",
+				comparator:     NewSetFieldComparator[*user, int](where.SetHasAll, setGetter, 1, 2),
+				expectedResult: true,
+				expectedCmp:    where.SetHasAll,
+				expectedField:  "set",
+				expectedValues: []any{1, 2},
+			},
+			{
+				name:           "SetHasAll 1, 3",
+				comparator:     NewSetFieldComparator[*user, int](where.SetHasAll, setGetter, 1, 3),
+				expectedResult: false,
+				expectedCmp:    where.SetHasAll,
+				expectedField:  "set",
+				expectedValues: []any{1, 3},
+			},
 			{
 				name:           "? 2",
 				comparator:     NewSetFieldComparator[*user, int](0, setGetter, 2),
@@ -1021,6 +1237,22 @@ func TestComparators(t *testing.T) { //nolint:maintidx
 				expectedField:  "string",
 				expectedValues: []any{"ff"},
 			},
+			{
+				name:           "foo STARTS_WITH fo",
+				comparator:     NewStringFieldComparator[*user](where.StartsWith, stringGetter, "fo"),
+				expectedResult: true,
+				expectedCmp:    where.StartsWith,
+				expectedField:  "string",
+				expectedValues: []any{"fo"},
+			},
+			{
+				name:           "foo STARTS_WITH oo",
+				comparator:     NewStringFieldComparator[*user](where.StartsWith, stringGetter, "oo"),
+				expectedResult: false,
+				expectedCmp:    where.StartsWith,
+				expectedField:  "string",
+				expectedValues: []any{"oo"},
+			},
 			{
 				name:           "foo ? bar",
 				comparator:     NewStringFieldComparator[*user](0, stringGetter, "bar"),
@@ -1074,4 +1306,436 @@ func TestComparators(t *testing.T) { //nolint:maintidx
 			},
 		})
 	})
+
+	t.Run("slice", func(t *testing.T) {
+		elementGetter := record.ComparableGetter[*user, int]{Field: sliceGetter.Field}
+
+		checkTestCases(t, []testCase{
+			{
+				name:           "SliceContains 5",
+				comparator:     NewSliceFieldComparator[*user, int](where.SliceContains, sliceGetter, 5),
+				expectedResult: true,
+				expectedCmp:    where.SliceContains,
+				expectedField:  "slice",
+				expectedValues: []any{5},
+			},
+			{
+				name:           "SliceContainsAll 3, 4",
+				comparator:     NewSliceFieldComparator[*user, int](where.SliceContainsAll, sliceGetter, 3, 4),
+				expectedResult: false,
+				expectedCmp:    where.SliceContainsAll,
+				expectedField:  "slice",
+				expectedValues: []any{3, 4},
+			},
+			{
+				name: "SliceAny > 4",
+				comparator: NewSliceAnyComparator[*user, int](
+					sliceGetter,
+					NewComparableFieldComparator[*user, int](where.GT, elementGetter, 4),
+				),
+				expectedResult: true,
+				expectedCmp:    where.SliceAny,
+				expectedField:  "slice",
+				expectedValues: []any{where.GT, 4},
+			},
+			{
+				name: "SliceAny IN (1, 2)",
+				comparator: NewSliceAnyComparator[*user, int](
+					sliceGetter,
+					NewComparableFieldComparator[*user, int](where.InArray, elementGetter, 1, 2),
+				),
+				expectedResult: false,
+				expectedCmp:    where.SliceAny,
+				expectedField:  "slice",
+				expectedValues: []any{where.InArray, 1, 2},
+			},
+			{
+				name:           "? 2",
+				comparator:     NewSliceFieldComparator[*user, int](0, sliceGetter, 2),
+				expectedResult: false,
+				expectedError:  NewNotImplementComparatorError(sliceGetter.Field, 0),
+				expectedCmp:    0,
+				expectedField:  "slice",
+				expectedValues: []any{2},
+			},
+		})
+	})
+
+	t.Run("fields", func(t *testing.T) {
+		sliceLenGetter := record.SliceLen(fields.New("slice_len"), sliceGetter)
+
+		checkTestCases(t, []testCase{
+			{
+				name:           "int > slice_len",
+				comparator:     NewFieldsComparator[*user](where.GT, intGetter, sliceLenGetter),
+				expectedResult: true,
+				expectedCmp:    where.GT,
+				expectedField:  "int",
+				expectedValues: []any{sliceLenGetter.Field},
+			},
+			{
+				name:           "int <= slice_len",
+				comparator:     NewFieldsComparator[*user](where.LE, intGetter, sliceLenGetter),
+				expectedResult: false,
+				expectedCmp:    where.LE,
+				expectedField:  "int",
+				expectedValues: []any{sliceLenGetter.Field},
+			},
+			{
+				name:           "int = int",
+				comparator:     NewFieldsComparator[*user](where.EQ, intGetter, intGetter),
+				expectedResult: true,
+				expectedCmp:    where.EQ,
+				expectedField:  "int",
+				expectedValues: []any{intGetter.Field},
+			},
+			{
+				name:           "int LIKE int",
+				comparator:     NewFieldsComparator[*user](where.Like, intGetter, intGetter),
+				expectedResult: false,
+				expectedError:  NewNotImplementComparatorError(intGetter.Field, where.Like),
+				expectedCmp:    where.Like,
+				expectedField:  "int",
+				expectedValues: []any{intGetter.Field},
+			},
+		})
+	})
+
+	t.Run("in set", func(t *testing.T) {
+		values := map[int]struct{}{30: {}, 10: {}, 20: {}}
+
+		checkTestCases(t, []testCase{
+			{
+				name:           "10 IN (10, 20, 30)",
+				comparator:     NewInSetComparator[*user](where.InArray, intGetter, values),
+				expectedResult: true,
+				expectedCmp:    where.InArray,
+				expectedField:  "int",
+				expectedValues: []any{10, 20, 30},
+			},
+			{
+				name:           "10 NOT IN (10, 20, 30)",
+				comparator:     NewInSetComparator[*user](where.NotInArray, intGetter, values),
+				expectedResult: false,
+				expectedCmp:    where.NotInArray,
+				expectedField:  "int",
+				expectedValues: []any{10, 20, 30},
+			},
+			{
+				name:           "10 NOT IN ()",
+				comparator:     NewInSetComparator[*user](where.NotInArray, intGetter, map[int]struct{}{}),
+				expectedResult: true,
+				expectedCmp:    where.NotInArray,
+				expectedField:  "int",
+				expectedValues: []any{},
+			},
+			{
+				name:           "10 IN (10, 20, 30) without constructor",
+				comparator:     InSetComparator[*user, int]{Cmp: where.InArray, Getter: intGetter, Value: values},
+				expectedResult: true,
+				expectedCmp:    where.InArray,
+				expectedField:  "int",
+				expectedValues: []any{10, 20, 30},
+			},
+			{
+				name:           "10 = (10, 20, 30)",
+				comparator:     NewInSetComparator[*user](where.EQ, intGetter, values),
+				expectedResult: false,
+				expectedError:  NewNotImplementComparatorError(intGetter.Field, where.EQ),
+				expectedCmp:    where.EQ,
+				expectedField:  "int",
+				expectedValues: []any{10, 20, 30},
+			},
+		})
+	})
+
+	t.Run("time", func(t *testing.T) {
+		// the same instant as item.time in other location
+		same := time.Date(2022, time.March, 1, 13, 0, 0, 0, time.FixedZone("+03:00", 3*60*60))
+		before := time.Date(2022, time.February, 1, 0, 0, 0, 0, time.UTC)
+		after := time.Date(2022, time.April, 1, 0, 0, 0, 0, time.UTC)
+
+		checkTestCases(t, []testCase{
+			{
+				name:           "time = same",
+				comparator:     NewTimeFieldComparator[*user](where.EQ, timeGetter, same),
+				expectedResult: true,
+				expectedCmp:    where.EQ,
+				expectedField:  "time",
+				expectedValues: []any{same},
+			},
+			{
+				name:           "time != same",
+				comparator:     NewTimeFieldComparator[*user](where.NE, timeGetter, same),
+				expectedResult: false,
+				expectedCmp:    where.NE,
+				expectedField:  "time",
+				expectedValues: []any{same},
+			},
+			{
+				name:           "time > before",
+				comparator:     NewTimeFieldComparator[*user](where.GT, timeGetter, before),
+				expectedResult: true,
+				expectedCmp:    where.GT,
+				expectedField:  "time",
+				expectedValues: []any{before},
+			},
+			{
+				name:           "time >= same",
+				comparator:     NewTimeFieldComparator[*user](where.GE, timeGetter, same),
+				expectedResult: true,
+				expectedCmp:    where.GE,
+				expectedField:  "time",
+				expectedValues: []any{same},
+			},
+			{
+				name:           "time < same",
+				comparator:     NewTimeFieldComparator[*user](where.LT, timeGetter, same),
+				expectedResult: false,
+				expectedCmp:    where.LT,
+				expectedField:  "time",
+				expectedValues: []any{same},
+			},
+			{
+				name:           "time <= after",
+				comparator:     NewTimeFieldComparator[*user](where.LE, timeGetter, after),
+				expectedResult: true,
+				expectedCmp:    where.LE,
+				expectedField:  "time",
+				expectedValues: []any{after},
+			},
+			{
+				name:           "time IN (before, same)",
+				comparator:     NewTimeFieldComparator[*user](where.InArray, timeGetter, before, same),
+				expectedResult: true,
+				expectedCmp:    where.InArray,
+				expectedField:  "time",
+				expectedValues: []any{before, same},
+			},
+			{
+				name:           "time NOT IN (before, after)",
+				comparator:     NewTimeFieldComparator[*user](where.NotInArray, timeGetter, before, after),
+				expectedResult: true,
+				expectedCmp:    where.NotInArray,
+				expectedField:  "time",
+				expectedValues: []any{before, after},
+			},
+			{
+				name:           "time BETWEEN before AND after",
+				comparator:     NewTimeFieldComparator[*user](where.Between, timeGetter, before, after),
+				expectedResult: true,
+				expectedCmp:    where.Between,
+				expectedField:  "time",
+				expectedValues: []any{before, after},
+			},
+			{
+				name: "time BETWEEN (before, same)",
+				comparator: TimeFieldComparator[*user]{
+					Cmp:    where.Between,
+					Getter: timeGetter,
+					Value:  []time.Time{before, same},
+					Bounds: where.Exclusive,
+				},
+				expectedResult: false,
+				expectedCmp:    where.Between,
+				expectedField:  "time",
+				expectedValues: []any{before, same},
+			},
+			{
+				name:           "time LIKE same",
+				comparator:     NewTimeFieldComparator[*user](where.Like, timeGetter, same),
+				expectedResult: false,
+				expectedError:  NewNotImplementComparatorError(timeGetter, where.Like),
+				expectedCmp:    where.Like,
+				expectedField:  "time",
+				expectedValues: []any{same},
+			},
+		})
+	})
+
+	t.Run("predicate", func(t *testing.T) {
+		errPredicate := errors.New("predicate error")
+
+		checkTestCases(t, []testCase{
+			{
+				name: "PREDICATE(has_slice)",
+				comparator: NewPredicateComparator("has_slice", func(item *user) (bool, error) {
+					return len(item.slice) > 0, nil
+				}),
+				expectedResult: true,
+				expectedCmp:    where.Predicate,
+				expectedField:  "has_slice",
+				expectedValues: []any{},
+			},
+			{
+				name: "PREDICATE(failed)",
+				comparator: NewPredicateComparator("failed", func(*user) (bool, error) {
+					return false, errPredicate
+				}),
+				expectedResult: false,
+				expectedError:  errPredicate,
+				expectedCmp:    where.Predicate,
+				expectedField:  "failed",
+				expectedValues: []any{},
+			},
+		})
+	})
+
+	t.Run("expression", func(t *testing.T) {
+		sum := expr.Add(expr.Field(intGetter), expr.Value[*user](5))
+		fifteen := expr.Value[*user](15)
+		zero := expr.Value[*user](0)
+
+		checkTestCases(t, []testCase{
+			{
+				name:           "int + 5 = 15",
+				comparator:     NewExpressionComparator(where.EQ, sum, fifteen),
+				expectedResult: true,
+				expectedCmp:    where.EQ,
+				expectedField:  "int + 5",
+				expectedValues: []any{fifteen},
+			},
+			{
+				name:           "int + 5 < 15",
+				comparator:     NewExpressionComparator(where.LT, sum, fifteen),
+				expectedResult: false,
+				expectedCmp:    where.LT,
+				expectedField:  "int + 5",
+				expectedValues: []any{fifteen},
+			},
+			{
+				name: "upper(string) >= \"FOO\"",
+				comparator: NewExpressionComparator(
+					where.GE,
+					expr.Upper(expr.Field(stringGetter)),
+					expr.Value[*user]("FOO"),
+				),
+				expectedResult: true,
+				expectedCmp:    where.GE,
+				expectedField:  "upper(string)",
+				expectedValues: []any{expr.Value[*user]("FOO")},
+			},
+			{
+				name:           "int / 0 > 0",
+				comparator:     NewExpressionComparator(where.GT, expr.Div(expr.Field(intGetter), zero), zero),
+				expectedResult: false,
+				expectedError:  expr.ErrDivisionByZero,
+				expectedCmp:    where.GT,
+				expectedField:  "int / 0",
+				expectedValues: []any{zero},
+			},
+			{
+				name:           "int + 5 IN 15",
+				comparator:     NewExpressionComparator(where.InArray, sum, fifteen),
+				expectedResult: false,
+				expectedError:  NewNotImplementComparatorError(computedField("int + 5"), where.InArray),
+				expectedCmp:    where.InArray,
+				expectedField:  "int + 5",
+				expectedValues: []any{fifteen},
+			},
+		})
+	})
+
+	t.Run("string fuzzy", func(t *testing.T) {
+		checkTestCases(t, []testCase{
+			{
+				name:           "foo Fuzzy fop 1 LEVENSHTEIN",
+				comparator:     NewFuzzyComparator[*user](where.Fuzzy, stringGetter, "fop", 1, distance.Levenshtein),
+				expectedResult: true,
+				expectedCmp:    where.Fuzzy,
+				expectedField:  "string",
+				expectedValues: []any{"fop", 1, "LEVENSHTEIN"},
+			},
+			{
+				name:           "foo Fuzzy ofo 1 DAMERAU",
+				comparator:     NewFuzzyComparator[*user](where.Fuzzy, stringGetter, "ofo", 1, distance.Damerau),
+				expectedResult: true,
+				expectedCmp:    where.Fuzzy,
+				expectedField:  "string",
+				expectedValues: []any{"ofo", 1, "DAMERAU"},
+			},
+			{
+				name:           "foo Fuzzy ofo 1 LEVENSHTEIN",
+				comparator:     NewFuzzyComparator[*user](where.Fuzzy, stringGetter, "ofo", 1, distance.Levenshtein),
+				expectedResult: false,
+				expectedCmp:    where.Fuzzy,
+				expectedField:  "string",
+				expectedValues: []any{"ofo", 1, "LEVENSHTEIN"},
+			},
+		})
+	})
+}
+
+func TestNullableComparator(t *testing.T) {
+	value := 10
+	withValue := &user{opt: &value}
+	withNull := &user{opt: nil}
+
+	testCases := []struct {
+		name         string
+		comparator   NullableFieldComparator[*user, int]
+		expectedNull bool
+		expectedTen  bool
+	}{
+		{
+			name:         "IS NULL",
+			comparator:   NewNullableFieldComparator[*user](where.IsNull, optGetter),
+			expectedNull: true,
+			expectedTen:  false,
+		},
+		{
+			name:         "IS NOT NULL",
+			comparator:   NewNullableFieldComparator[*user](where.IsNotNull, optGetter),
+			expectedNull: false,
+			expectedTen:  true,
+		},
+		{
+			name:         "= 10",
+			comparator:   NewNullableFieldComparator[*user](where.EQ, optGetter, 10),
+			expectedNull: false,
+			expectedTen:  true,
+		},
+		{
+			name:         "!= 10",
+			comparator:   NewNullableFieldComparator[*user](where.NE, optGetter, 10),
+			expectedNull: true,
+			expectedTen:  false,
+		},
+		{
+			name:         "< 20",
+			comparator:   NewNullableFieldComparator[*user](where.LT, optGetter, 20),
+			expectedNull: false,
+			expectedTen:  true,
+		},
+		{
+			name:         "IN (1, 10)",
+			comparator:   NewNullableFieldComparator[*user](where.InArray, optGetter, 1, 10),
+			expectedNull: false,
+			expectedTen:  true,
+		},
+		{
+			name:         "NOT IN (1, 2)",
+			comparator:   NewNullableFieldComparator[*user](where.NotInArray, optGetter, 1, 2),
+			expectedNull: true,
+			expectedTen:  true,
+		},
+		{
+			name:         "BETWEEN 0 AND 10",
+			comparator:   NewNullableFieldComparator[*user](where.Between, optGetter, 0, 10),
+			expectedNull: false,
+			expectedTen:  true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			res, err := testCase.comparator.Compare(withNull)
+			asserts.Success(t, err)
+			asserts.Equals(t, testCase.expectedNull, res, "null")
+
+			res, err = testCase.comparator.Compare(withValue)
+			asserts.Success(t, err)
+			asserts.Equals(t, testCase.expectedTen, res, "value")
+		})
+	}
 }
@@ -27,6 +27,10 @@ func (fc EqualComparator[R, T]) CompareValue(value T) (bool, error) {
 		return value == fc.Value[0], nil
 	case where.InArray:
 		return slices.Index(fc.Value, value) >= 0, nil
+	case where.NE:
+		return value != fc.Value[0], nil
+	case where.NotInArray:
+		return slices.Index(fc.Value, value) < 0, nil
 	default:
 		return false, NewNotImplementComparatorError(fc.GetField(), fc.Cmp)
 	}
@@ -0,0 +1,81 @@
+package comparators
+
+import (
+	"github.com/shamcode/simd/expr"
+	"github.com/shamcode/simd/record"
+	"github.com/shamcode/simd/where"
+)
+
+// ExpressionComparator compares values of two expressions: Left Cmp Right.
+// Field of the comparator is named by Left expression, value is Right expression.
+type ExpressionComparator[R record.Record, T record.LessComparable] struct {
+	Cmp   where.ComparatorType
+	Left  expr.Expr[R, T]
+	Right expr.Expr[R, T]
+}
+
+func (ec ExpressionComparator[R, T]) GetType() where.ComparatorType {
+	return ec.Cmp
+}
+
+func (ec ExpressionComparator[R, T]) GetField() record.Field {
+	return computedField(ec.Left.String())
+}
+
+func (ec ExpressionComparator[R, T]) IsComputed() bool {
+	return true
+}
+
+func (ec ExpressionComparator[R, T]) CompareValues(left, right T) (bool, error) {
+	switch ec.Cmp { //nolint:exhaustive
+	case where.EQ:
+		return left == right, nil
+	case where.NE:
+		return left != right, nil
+	case where.GT:
+		return left > right, nil
+	case where.GE:
+		return left >= right, nil
+	case where.LT:
+		return left < right, nil
+	case where.LE:
+		return left <= right, nil
+	default:
+		return false, NewNotImplementComparatorError(ec.GetField(), ec.Cmp)
+	}
+}
+
+func (ec ExpressionComparator[R, T]) Compare(item R) (bool, error) {
+	left, err := ec.Left.Eval(item)
+	if err != nil {
+		return false, err
+	}
+
+	right, err := ec.Right.Eval(item)
+	if err != nil {
+		return false, err
+	}
+
+	return ec.CompareValues(left, right)
+}
+
+// ValuesCount returns 1: right expression.
+func (ec ExpressionComparator[R, T]) ValuesCount() int {
+	return 1
+}
+
+func (ec ExpressionComparator[R, T]) ValueAt(int) any {
+	return ec.Right
+}
+
+func NewExpressionComparator[R record.Record, T record.LessComparable](
+	cmp where.ComparatorType,
+	left expr.Expr[R, T],
+	right expr.Expr[R, T],
+) ExpressionComparator[R, T] {
+	return ExpressionComparator[R, T]{
+		Cmp:   cmp,
+		Left:  left,
+		Right: right,
+	}
+}
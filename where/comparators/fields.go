@@ -0,0 +1,69 @@
+package comparators
+
+import (
+	"github.com/shamcode/simd/record"
+	"github.com/shamcode/simd/where"
+)
+
+// FieldsComparator compares values of two fields of the same record: Getter Cmp Other.
+type FieldsComparator[R record.Record, T record.LessComparable] struct {
+	Cmp    where.ComparatorType
+	Getter record.ComparableGetter[R, T]
+	Other  record.ComparableGetter[R, T]
+}
+
+func (fc FieldsComparator[R, T]) GetType() where.ComparatorType {
+	return fc.Cmp
+}
+
+func (fc FieldsComparator[R, T]) GetField() record.Field {
+	return fc.Getter.Field
+}
+
+func (fc FieldsComparator[R, T]) GetOtherField() record.Field {
+	return fc.Other.Field
+}
+
+func (fc FieldsComparator[R, T]) CompareValues(value, other T) (bool, error) {
+	switch fc.Cmp { //nolint:exhaustive
+	case where.EQ:
+		return value == other, nil
+	case where.NE:
+		return value != other, nil
+	case where.GT:
+		return value > other, nil
+	case where.GE:
+		return value >= other, nil
+	case where.LT:
+		return value < other, nil
+	case where.LE:
+		return value <= other, nil
+	default:
+		return false, NewNotImplementComparatorError(fc.GetField(), fc.Cmp)
+	}
+}
+
+func (fc FieldsComparator[R, T]) Compare(item R) (bool, error) {
+	return fc.CompareValues(fc.Getter.Get(item), fc.Other.Get(item))
+}
+
+// ValuesCount returns 1: other field.
+func (fc FieldsComparator[R, T]) ValuesCount() int {
+	return 1
+}
+
+func (fc FieldsComparator[R, T]) ValueAt(int) any {
+	return fc.Other.Field
+}
+
+func NewFieldsComparator[R record.Record, T record.LessComparable](
+	cmp where.ComparatorType,
+	getter record.ComparableGetter[R, T],
+	other record.ComparableGetter[R, T],
+) FieldsComparator[R, T] {
+	return FieldsComparator[R, T]{
+		Cmp:    cmp,
+		Getter: getter,
+		Other:  other,
+	}
+}
@@ -0,0 +1,74 @@
+package comparators
+
+import (
+	"github.com/shamcode/simd/distance"
+	"github.com/shamcode/simd/record"
+	"github.com/shamcode/simd/where"
+)
+
+// FuzzyComparator checks that edit distance between value of string field and term is not greater than MaxEdits.
+type FuzzyComparator[R record.Record] struct {
+	Cmp      where.ComparatorType
+	Getter   record.GetterInterface[R, string]
+	Term     string
+	MaxEdits int
+	Metric   distance.Metric
+}
+
+func (fc FuzzyComparator[R]) GetType() where.ComparatorType {
+	return fc.Cmp
+}
+
+func (fc FuzzyComparator[R]) GetField() record.Field {
+	return fc.Getter
+}
+
+func (fc FuzzyComparator[R]) GetMetric() distance.Metric {
+	return fc.Metric
+}
+
+func (fc FuzzyComparator[R]) CompareValue(value string) (bool, error) {
+	if fc.Cmp != where.Fuzzy {
+		return false, NewNotImplementComparatorError(fc.GetField(), fc.Cmp)
+	}
+
+	return fc.Metric.Distance(value, fc.Term) <= fc.MaxEdits, nil
+}
+
+func (fc FuzzyComparator[R]) Compare(item R) (bool, error) {
+	return fc.CompareValue(fc.Getter.GetForRecord(item))
+}
+
+// ValuesCount returns 3: term, max edits and name of metric.
+func (fc FuzzyComparator[R]) ValuesCount() int {
+	return 3 //nolint:mnd
+}
+
+func (fc FuzzyComparator[R]) ValueAt(index int) any {
+	switch index {
+	case 0:
+		return fc.Term
+	case 1:
+		return fc.MaxEdits
+	case 2: //nolint:mnd
+		return fc.Metric.String()
+	default:
+		return nil
+	}
+}
+
+func NewFuzzyComparator[R record.Record](
+	cmp where.ComparatorType,
+	getter record.GetterInterface[R, string],
+	term string,
+	maxEdits int,
+	metric distance.Metric,
+) FuzzyComparator[R] {
+	return FuzzyComparator[R]{
+		Cmp:      cmp,
+		Getter:   getter,
+		Term:     term,
+		MaxEdits: maxEdits,
+		Metric:   metric,
+	}
+}
@@ -0,0 +1,94 @@
+package comparators
+
+import (
+	"iter"
+	"maps"
+	"slices"
+	"sync"
+
+	"github.com/shamcode/simd/record"
+	"github.com/shamcode/simd/where"
+)
+
+// InSetComparator checks that value of field is in the set of values (where.InArray) or not (where.NotInArray).
+// Values are stored in the set, so checking doesn't depend on count of values.
+type InSetComparator[R record.Record, T record.LessComparable] struct {
+	Cmp    where.ComparatorType
+	Getter record.GetterInterface[R, T]
+	Value  map[T]struct{}
+
+	// sorted is sorted values for ValueAt, created on first call
+	sorted *sortedValues[T]
+}
+
+type sortedValues[T record.LessComparable] struct {
+	once   sync.Once
+	values []T
+}
+
+func (fc InSetComparator[R, T]) GetType() where.ComparatorType {
+	return fc.Cmp
+}
+
+func (fc InSetComparator[R, T]) GetField() record.Field {
+	return fc.Getter
+}
+
+func (fc InSetComparator[R, T]) CompareValue(value T) (bool, error) {
+	_, ok := fc.Value[value]
+
+	switch fc.Cmp { //nolint:exhaustive
+	case where.InArray:
+		return ok, nil
+	case where.NotInArray:
+		return !ok, nil
+	default:
+		return false, NewNotImplementComparatorError(fc.GetField(), fc.Cmp)
+	}
+}
+
+func (fc InSetComparator[R, T]) Compare(item R) (bool, error) {
+	return fc.CompareValue(fc.Getter.GetForRecord(item))
+}
+
+// Values iterates values of the set in random order.
+func (fc InSetComparator[R, T]) Values() iter.Seq[any] {
+	return func(yield func(any) bool) {
+		for value := range fc.Value {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+func (fc InSetComparator[R, T]) ValuesCount() int {
+	return len(fc.Value)
+}
+
+// ValueAt returns value by index in sorted values, values are copied and sorted on first call.
+// Comparator created without NewInSetComparator copies and sorts values on every call.
+func (fc InSetComparator[R, T]) ValueAt(index int) any {
+	if nil == fc.sorted {
+		return slices.Sorted(maps.Keys(fc.Value))[index]
+	}
+
+	fc.sorted.once.Do(func() {
+		fc.sorted.values = slices.Sorted(maps.Keys(fc.Value))
+	})
+
+	return fc.sorted.values[index]
+}
+
+func NewInSetComparator[R record.Record, T record.LessComparable](
+	cmp where.ComparatorType,
+	getter record.GetterInterface[R, T],
+	value map[T]struct{},
+) InSetComparator[R, T] {
+	return InSetComparator[R, T]{
+		Cmp:    cmp,
+		Getter: getter,
+		Value:  value,
+		sorted: &sortedValues[T]{}, //nolint:exhaustruct
+	}
+}
@@ -37,6 +37,26 @@ func (fc MapFieldComparator[R, K, V]) CompareValue(value record.Map[K, V]) (bool
 		}
 
 		return value.HasKey(val), nil
+	case where.MapKeyValueEQ:
+		key, ok := fc.Value[0].(K)
+		if !ok {
+			return false, NewFailCastTypeError(fc.GetField(), fc.Cmp, fc.Value[0], fmt.Sprintf("%T", key))
+		}
+
+		expected, ok := fc.Value[1].(V)
+		if !ok {
+			return false, NewFailCastTypeError(fc.GetField(), fc.Cmp, fc.Value[1], fmt.Sprintf("%T", expected))
+		}
+
+		entries, ok := value.(record.MapEntries[K, V])
+		if !ok {
+			return false, NewFailCastTypeError(fc.GetField(), fc.Cmp, value, "record.MapEntries")
+		}
+
+		actual, exists := entries.Get(key)
+
+		// Values are compared as interfaces, V must be comparable
+		return exists && any(actual) == any(expected), nil
 	default:
 		return false, NewNotImplementComparatorError(fc.GetField(), fc.Cmp)
 	}
@@ -0,0 +1,80 @@
+package comparators
+
+import (
+	"github.com/shamcode/simd/record"
+	"github.com/shamcode/simd/where"
+)
+
+// NullableFieldComparator is a comparator for nullable field.
+// NULL is distinct from any value: NULL matches IsNull, NE and NotInArray, other comparisons are false.
+type NullableFieldComparator[R record.Record, T record.LessComparable] struct {
+	Cmp    where.ComparatorType
+	Getter record.NullableGetter[R, T]
+	Value  []T
+
+	// Bounds is used by where.Between, Value contains low and high values.
+	Bounds where.Bounds
+}
+
+func (fc NullableFieldComparator[R, T]) GetType() where.ComparatorType {
+	return fc.Cmp
+}
+
+func (fc NullableFieldComparator[R, T]) GetField() record.Field {
+	return fc.Getter.Field
+}
+
+func (fc NullableFieldComparator[R, T]) GetBounds() where.Bounds {
+	return fc.Bounds
+}
+
+func (fc NullableFieldComparator[R, T]) CompareNullable(value T, valid bool) (bool, error) {
+	switch fc.Cmp { //nolint:exhaustive
+	case where.IsNull:
+		return !valid, nil
+	case where.IsNotNull:
+		return valid, nil
+	case where.NE, where.NotInArray:
+		if !valid {
+			return true, nil
+		}
+	}
+
+	if !valid {
+		return false, nil
+	}
+
+	return ComparableFieldComparator[R, T]{
+		EqualComparator: EqualComparator[R, T]{
+			Cmp:    fc.Cmp,
+			Getter: fc.Getter,
+			Value:  fc.Value,
+		},
+		Bounds: fc.Bounds,
+	}.CompareValue(value)
+}
+
+func (fc NullableFieldComparator[R, T]) Compare(item R) (bool, error) {
+	return fc.CompareNullable(fc.Getter.Get(item))
+}
+
+func (fc NullableFieldComparator[R, T]) ValuesCount() int {
+	return len(fc.Value)
+}
+
+func (fc NullableFieldComparator[R, T]) ValueAt(index int) any {
+	return fc.Value[index]
+}
+
+func NewNullableFieldComparator[R record.Record, T record.LessComparable](
+	cmp where.ComparatorType,
+	getter record.NullableGetter[R, T],
+	value ...T,
+) NullableFieldComparator[R, T] {
+	return NullableFieldComparator[R, T]{
+		Cmp:    cmp,
+		Getter: getter,
+		Value:  value,
+		Bounds: where.Inclusive,
+	}
+}
@@ -0,0 +1,50 @@
+package comparators
+
+import (
+	"github.com/shamcode/simd/record"
+	"github.com/shamcode/simd/where"
+)
+
+// computedField is a field of value computed from the whole record, it's named by predicate or expression.
+// Computed fields aren't registered by record.FieldsConstructor and have no indexes.
+type computedField string
+
+func (f computedField) Index() uint16  { return record.ComputedFieldIndex }
+func (f computedField) String() string { return string(f) }
+
+// PredicateComparator checks record by arbitrary function, Name is used by debug dumps and query fingerprints.
+type PredicateComparator[R record.Record] struct {
+	Name string
+	Fn   func(item R) (bool, error)
+}
+
+func (pc PredicateComparator[R]) GetType() where.ComparatorType {
+	return where.Predicate
+}
+
+func (pc PredicateComparator[R]) GetField() record.Field {
+	return computedField(pc.Name)
+}
+
+func (pc PredicateComparator[R]) IsComputed() bool {
+	return true
+}
+
+func (pc PredicateComparator[R]) Compare(item R) (bool, error) {
+	return pc.Fn(item)
+}
+
+func (pc PredicateComparator[R]) ValuesCount() int {
+	return 0
+}
+
+func (pc PredicateComparator[R]) ValueAt(int) any {
+	return nil
+}
+
+func NewPredicateComparator[R record.Record](name string, fn func(item R) (bool, error)) PredicateComparator[R] {
+	return PredicateComparator[R]{
+		Name: name,
+		Fn:   fn,
+	}
+}
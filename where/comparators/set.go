@@ -23,6 +23,22 @@ func (fc SetFieldComparator[R, T]) CompareValue(value record.Set[T]) (bool, erro
 	switch fc.Cmp { //nolint:exhaustive
 	case where.SetHas:
 		return value.Has(fc.Value[0]), nil
+	case where.SetHasAny:
+		for _, item := range fc.Value {
+			if value.Has(item) {
+				return true, nil
+			}
+		}
+
+		return false, nil
+	case where.SetHasAll:
+		for _, item := range fc.Value {
+			if !value.Has(item) {
+				return false, nil
+			}
+		}
+
+		return true, nil
 	default:
 		return false, NewNotImplementComparatorError(fc.GetField(), fc.Cmp)
 	}
@@ -0,0 +1,125 @@
+package comparators
+
+import (
+	"slices"
+
+	"github.com/shamcode/simd/record"
+	"github.com/shamcode/simd/where"
+)
+
+type SliceFieldComparator[R record.Record, T comparable] struct {
+	Cmp    where.ComparatorType
+	Getter record.SliceGetter[R, T]
+	Value  []T
+}
+
+func (fc SliceFieldComparator[R, T]) GetType() where.ComparatorType {
+	return fc.Cmp
+}
+
+func (fc SliceFieldComparator[R, T]) GetField() record.Field {
+	return fc.Getter.Field
+}
+
+func (fc SliceFieldComparator[R, T]) CompareValue(value []T) (bool, error) {
+	switch fc.Cmp { //nolint:exhaustive
+	case where.SliceContains:
+		return slices.Contains(value, fc.Value[0]), nil
+	case where.SliceContainsAll:
+		for _, item := range fc.Value {
+			if !slices.Contains(value, item) {
+				return false, nil
+			}
+		}
+
+		return true, nil
+	default:
+		return false, NewNotImplementComparatorError(fc.GetField(), fc.Cmp)
+	}
+}
+
+func (fc SliceFieldComparator[R, T]) Compare(item R) (bool, error) {
+	return fc.CompareValue(fc.Getter.Get(item))
+}
+
+func (fc SliceFieldComparator[R, T]) ValuesCount() int {
+	return len(fc.Value)
+}
+
+func (fc SliceFieldComparator[R, T]) ValueAt(index int) any {
+	return fc.Value[index]
+}
+
+func NewSliceFieldComparator[R record.Record, T comparable](
+	cmp where.ComparatorType,
+	getter record.SliceGetter[R, T],
+	value ...T,
+) SliceFieldComparator[R, T] {
+	return SliceFieldComparator[R, T]{
+		Cmp:    cmp,
+		Getter: getter,
+		Value:  value,
+	}
+}
+
+// ElementComparator compares single element of slice, for example ComparableFieldComparator.
+type ElementComparator[R record.Record, T any] interface {
+	where.FieldComparator[R]
+	CompareValue(value T) (bool, error)
+}
+
+// SliceAnyComparator checks that any element of slice satisfies Element comparator.
+// Values are type of Element comparator and its values.
+type SliceAnyComparator[R record.Record, T comparable] struct {
+	Getter  record.SliceGetter[R, T]
+	Element ElementComparator[R, T]
+}
+
+func (fc SliceAnyComparator[R, T]) GetType() where.ComparatorType {
+	return where.SliceAny
+}
+
+func (fc SliceAnyComparator[R, T]) GetField() record.Field {
+	return fc.Getter.Field
+}
+
+func (fc SliceAnyComparator[R, T]) GetElement() where.FieldComparator[R] {
+	return fc.Element
+}
+
+func (fc SliceAnyComparator[R, T]) CompareValue(value []T) (bool, error) {
+	for _, item := range value {
+		res, err := fc.Element.CompareValue(item)
+		if err != nil || res {
+			return res, err
+		}
+	}
+
+	return false, nil
+}
+
+func (fc SliceAnyComparator[R, T]) Compare(item R) (bool, error) {
+	return fc.CompareValue(fc.Getter.Get(item))
+}
+
+func (fc SliceAnyComparator[R, T]) ValuesCount() int {
+	return 1 + fc.Element.ValuesCount()
+}
+
+func (fc SliceAnyComparator[R, T]) ValueAt(index int) any {
+	if index == 0 {
+		return fc.Element.GetType()
+	}
+
+	return fc.Element.ValueAt(index - 1)
+}
+
+func NewSliceAnyComparator[R record.Record, T comparable](
+	getter record.SliceGetter[R, T],
+	element ElementComparator[R, T],
+) SliceAnyComparator[R, T] {
+	return SliceAnyComparator[R, T]{
+		Getter:  getter,
+		Element: element,
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/shamcode/simd/fold"
 	"github.com/shamcode/simd/record"
 	"github.com/shamcode/simd/where"
 )
@@ -26,6 +27,8 @@ func (fc StringFieldComparator[R]) CompareValue(value string) (bool, error) {
 	switch fc.Cmp { //nolint:exhaustive
 	case where.Like:
 		return strings.Contains(value, fc.Value[0]), nil
+	case where.StartsWith:
+		return strings.HasPrefix(value, fc.Value[0]), nil
 	default:
 		return fc.ComparableFieldComparator.CompareValue(value)
 	}
@@ -89,3 +92,36 @@ func NewStringFieldRegexpComparator[R record.Record](
 		Value:  value,
 	}
 }
+
+// FoldedStringFieldComparator is a comparator for case- and accent-insensitive matching of string field.
+// Values must be folded by fold.String, value of record is folded before comparing.
+type FoldedStringFieldComparator[R record.Record] struct {
+	StringFieldComparator[R]
+}
+
+func (fc FoldedStringFieldComparator[R]) IsFolding() bool {
+	return true
+}
+
+func (fc FoldedStringFieldComparator[R]) CompareValue(value string) (bool, error) {
+	return fc.StringFieldComparator.CompareValue(fold.String(value))
+}
+
+func (fc FoldedStringFieldComparator[R]) Compare(item R) (bool, error) {
+	return fc.CompareValue(fc.Getter.GetForRecord(item))
+}
+
+func NewFoldedStringFieldComparator[R record.Record](
+	cmp where.ComparatorType,
+	getter record.ComparableGetter[R, string],
+	values ...string,
+) FoldedStringFieldComparator[R] {
+	folded := make([]string, len(values))
+	for i, value := range values {
+		folded[i] = fold.String(value)
+	}
+
+	return FoldedStringFieldComparator[R]{
+		StringFieldComparator: NewStringFieldComparator(cmp, getter, folded...),
+	}
+}
@@ -0,0 +1,81 @@
+package comparators
+
+import (
+	"slices"
+	"time"
+
+	"github.com/shamcode/simd/record"
+	"github.com/shamcode/simd/where"
+)
+
+// TimeFieldComparator compares instants of time, locations and monotonic clock readings of values are ignored.
+type TimeFieldComparator[R record.Record] struct {
+	Cmp    where.ComparatorType
+	Getter record.GetterInterface[R, time.Time]
+	Value  []time.Time
+
+	// Bounds is used by where.Between, Value contains low and high values.
+	Bounds where.Bounds
+}
+
+func (fc TimeFieldComparator[R]) GetType() where.ComparatorType {
+	return fc.Cmp
+}
+
+func (fc TimeFieldComparator[R]) GetField() record.Field {
+	return fc.Getter
+}
+
+func (fc TimeFieldComparator[R]) GetBounds() where.Bounds {
+	return fc.Bounds
+}
+
+func (fc TimeFieldComparator[R]) CompareValue(value time.Time) (bool, error) {
+	switch fc.Cmp { //nolint:exhaustive
+	case where.EQ:
+		return value.Equal(fc.Value[0]), nil
+	case where.NE:
+		return !value.Equal(fc.Value[0]), nil
+	case where.GT:
+		return value.After(fc.Value[0]), nil
+	case where.LT:
+		return value.Before(fc.Value[0]), nil
+	case where.GE:
+		return !value.Before(fc.Value[0]), nil
+	case where.LE:
+		return !value.After(fc.Value[0]), nil
+	case where.InArray:
+		return slices.ContainsFunc(fc.Value, value.Equal), nil
+	case where.NotInArray:
+		return !slices.ContainsFunc(fc.Value, value.Equal), nil
+	case where.Between:
+		return fc.Bounds.Contains(value.Compare(fc.Value[0]), value.Compare(fc.Value[1])), nil
+	default:
+		return false, NewNotImplementComparatorError(fc.GetField(), fc.Cmp)
+	}
+}
+
+func (fc TimeFieldComparator[R]) Compare(item R) (bool, error) {
+	return fc.CompareValue(fc.Getter.GetForRecord(item))
+}
+
+func (fc TimeFieldComparator[R]) ValuesCount() int {
+	return len(fc.Value)
+}
+
+func (fc TimeFieldComparator[R]) ValueAt(index int) any {
+	return fc.Value[index]
+}
+
+func NewTimeFieldComparator[R record.Record](
+	cmp where.ComparatorType,
+	getter record.GetterInterface[R, time.Time],
+	value ...time.Time,
+) TimeFieldComparator[R] {
+	return TimeFieldComparator[R]{
+		Cmp:    cmp,
+		Getter: getter,
+		Value:  value,
+		Bounds: where.Inclusive,
+	}
+}
@@ -10,6 +10,9 @@ type Condition[R record.Record] struct {
 	WithNot      bool
 	IsOr         bool
 	BracketLevel int
+	// OpenBrackets is a count of brackets opened right before the condition, it separates sibling groups
+	// like (A OR B) AND (C OR D). Condition without opened brackets continues the group of its bracket level.
+	OpenBrackets int
 	Cmp          FieldComparator[R]
 }
 
@@ -17,70 +20,108 @@ func (c Condition[R]) String() string {
 	return fmt.Sprintf("{%t %t %d %s %d}", c.WithNot, c.IsOr, c.BracketLevel, c.Cmp.GetField(), c.Cmp.GetType())
 }
 
+// Conditions are applied from left to right on each bracket level: A OR B AND C is (A OR B) AND C.
+// Bracket group is joined to the previous item by operation of its first condition.
 type Conditions[R record.Record] []Condition[R]
 
+// Item checks that the condition at pos belongs to the group on the bracket level. The condition is the first
+// item of nested group, if it's deeper than the level, nestedOpened is a count of its brackets entered
+// by the nested group. opened is a count of brackets of the condition already entered by the caller,
+// it's zero for all items except the first one.
+func (w Conditions[R]) Item(pos, opened, level int) (inGroup, nested bool, nestedOpened int) { //nolint:nonamedreturns
+	condition := w[pos]
+
+	depth := condition.BracketLevel - condition.OpenBrackets + opened
+	if depth < level {
+		// Bracket of the level is closed before the condition
+		return false, false, 0
+	}
+
+	switch {
+	case condition.BracketLevel == level:
+		return true, false, 0
+	case depth == level:
+		// Condition opens bracket of the nested group
+		return true, true, opened + 1
+	default:
+		return true, true, opened
+	}
+}
+
 // Check checks that the record satisfies all the conditions.
-func (w Conditions[R]) Check(item R) (bool, error) { //nolint:cyclop
-	stack := make(resultsByBracketLevel)
-	lastBracketLevel := 0
-
-	for _, condition := range w {
-		isAnd := !condition.IsOr
-
-		if lastBracketLevel > 0 { //nolint:nestif
-			last := stack[lastBracketLevel]
-			if condition.BracketLevel >= lastBracketLevel {
-				if !last.opRecognized {
-					// A AND B
-					// A OR B
-					// A AND (B ... )
-					// A OR (B ... )
-					last.isAnd = isAnd
-					last.opRecognized = true
-				}
-
-				if last.isAnd != last.value && last.isAnd == isAnd {
-					// lazy or/and
-					// skip Condition
-					continue
-				}
-			}
-		}
+func (w Conditions[R]) Check(item R) (bool, error) {
+	if len(w) == 0 {
+		return true, nil
+	}
 
-		compareResultForItem, err := condition.Cmp.Compare(item)
-		if err != nil {
-			return false, err
+	res, _, err := w.check(item, 0, 0, 1)
+
+	return res, err
+}
+
+// check checks group on the bracket level from pos and returns position after the group.
+// Items, which can't change result of the group, are skipped: false AND A, true OR A.
+func (w Conditions[R]) check(item R, pos, opened, level int) (bool, int, error) {
+	var (
+		result bool
+		err    error
+	)
+
+	first := true
+
+	for pos < len(w) {
+		inGroup, nested, nestedOpened := w.Item(pos, opened, level)
+		if !inGroup {
+			break
 		}
 
-		// Expression A != B it's analog for (A && !B) || (!A && B)
-		conditionResult := condition.WithNot != compareResultForItem
-
-		if lastBracketLevel > condition.BracketLevel { //nolint:nestif
-			// ( ... ) AND B
-			// ( ... ) OR B
-			if isAnd != conditionResult {
-				// Lazy reduce:
-				// ( ... ) AND False == False
-				// ( ... ) OR True == True
-				stack.pop(lastBracketLevel, condition.BracketLevel)
+		opened = 0
+
+		if !first && result == w[pos].IsOr {
+			if nested {
+				pos = w.skip(pos, nestedOpened, level+1)
 			} else {
-				subBracketsResult := stack.reduce(lastBracketLevel, condition.BracketLevel)
-				if isAnd {
-					conditionResult = subBracketsResult && conditionResult
-				} else {
-					conditionResult = subBracketsResult || conditionResult
-				}
+				pos++
 			}
+
+			continue
 		}
 
-		stack.save(condition.BracketLevel, conditionResult, isAnd)
+		// Result isn't known, so it's equal to result of the item: true AND A == A, false OR A == A
+		if nested {
+			result, pos, err = w.check(item, pos, nestedOpened, level+1)
+		} else {
+			result, err = w[pos].Cmp.Compare(item)
+			result = w[pos].WithNot != result
+			pos++
+		}
+
+		if err != nil {
+			return false, pos, err
+		}
 
-		lastBracketLevel = condition.BracketLevel
+		first = false
 	}
 
-	if len(stack) == 0 {
-		return true, nil
+	return result, pos, nil
+}
+
+// skip returns position after the group on the bracket level.
+func (w Conditions[R]) skip(pos, opened, level int) int {
+	for pos < len(w) {
+		inGroup, nested, nestedOpened := w.Item(pos, opened, level)
+		if !inGroup {
+			break
+		}
+
+		opened = 0
+
+		if nested {
+			pos = w.skip(pos, nestedOpened, level+1)
+		} else {
+			pos++
+		}
 	}
 
-	return stack.reduce(lastBracketLevel, 0), nil
+	return pos
 }